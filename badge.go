@@ -0,0 +1,72 @@
+package skeleton
+
+// BadgeState is the severity of a tab badge, it sets the color of the badge.
+type BadgeState int
+
+const (
+	// BadgeInfo is rendered with the accent color of the theme, e.g. the unread counts
+	BadgeInfo BadgeState = iota + 1
+
+	// BadgeWarning is rendered with the warning color of the theme
+	BadgeWarning
+
+	// BadgeError is rendered with the error color of the theme
+	BadgeError
+)
+
+// Badge is hold the counter or the dot rendered next to the title of a tab. The zero Badge is no badge.
+type Badge struct {
+	// Text is the text of the badge, e.g. an unread count. Empty text renders a dot.
+	Text string
+
+	// State is the severity of the badge
+	State BadgeState
+}
+
+// UpdatePageBadge sets the badge of the page by the given key.
+type UpdatePageBadge struct {
+	// Key is unique key of the page, it is used to identify the page
+	Key string
+
+	// Badge is the badge of the page, the zero Badge removes it
+	Badge Badge
+}
+
+// SetPageBadge sets the badge of the page by the given key, e.g. the count of the unread lines of a background page.
+// Empty text renders a dot. The badge is removed when the page is activated unless ClearBadgesOnActivate is disabled.
+// The indicators of the hidden tabs show the most severe badge of the tabs they hide.
+func (s *Skeleton) SetPageBadge(key string, text string, state BadgeState) *Skeleton {
+	go func() {
+		s.updateChan <- UpdatePageBadge{
+			Key:   key,
+			Badge: Badge{Text: text, State: state},
+		}
+	}()
+	return s
+}
+
+// ClearPageBadge removes the badge of the page by the given key.
+func (s *Skeleton) ClearPageBadge(key string) *Skeleton {
+	go func() {
+		s.updateChan <- UpdatePageBadge{
+			Key: key,
+		}
+	}()
+	return s
+}
+
+// GetPageBadge returns the badge of the page by the given key, it is the zero Badge if the page has none.
+func (s *Skeleton) GetPageBadge(key string) Badge {
+	return s.header.GetBadge(key)
+}
+
+// ClearBadgesOnActivate sets the badge of a page is removed when the page is activated or not, it is enabled by default.
+func (s *Skeleton) ClearBadgesOnActivate(clear bool) *Skeleton {
+	s.header.SetClearBadges(clear)
+	return s
+}
+
+// IsClearBadgesOnActivate returns the badge of a page is removed when the page is activated or not.
+func (s *Skeleton) IsClearBadgesOnActivate() bool {
+	return s.header.GetClearBadges()
+}
@@ -0,0 +1,21 @@
+package skeleton
+
+import "testing"
+
+func TestUpdatePageBadge(t *testing.T) {
+	s, _ := newTestSkeleton("logs", "jobs")
+
+	badge := Badge{Text: "3", State: BadgeWarning}
+	s.Update(UpdatePageBadge{Key: "jobs", Badge: badge})
+	if got := s.GetPageBadge("jobs"); got != badge {
+		t.Fatalf("badge = %+v, want %+v", got, badge)
+	}
+	if got := s.GetPageBadge("logs"); got != (Badge{}) {
+		t.Errorf("badge of the other page = %+v, want none", got)
+	}
+
+	s.Update(UpdatePageBadge{Key: "jobs"})
+	if got := s.GetPageBadge("jobs"); got != (Badge{}) {
+		t.Errorf("badge = %+v, want the zero badge to remove it", got)
+	}
+}
@@ -0,0 +1,190 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BorderSet is hold the characters the frame, the tabs and the widgets are drawn with.
+// The left and the right sides of the tab borders join the tabs to the frame line, e.g. ┤ and ├.
+type BorderSet struct {
+	// Name is the name of the border set
+	Name string
+
+	// Frame is the border of the outer frame and the overlays
+	Frame lipgloss.Border
+
+	// Tab is the border of the inactive tabs and the widgets
+	Tab lipgloss.Border
+
+	// ActiveTab is the border of the active tab
+	ActiveTab lipgloss.Border
+}
+
+// glyphs are hold the non-border characters of the chrome, they are replaced in ASCII-only mode.
+type glyphs struct {
+	close       string // close button of the tabs
+	pointer     string // prompt and selected item marker of the overlays
+	ellipsis    string // truncated lists, key ranges and pending key sequences
+	separator   string // separator of the short help
+	left        string // left arrow on the key help
+	right       string // right arrow on the key help
+	scrollLeft  string // hidden tabs and widgets before the visible ones
+	scrollRight string // hidden tabs and widgets after the visible ones
+	badge       string // badges without a text
+	spinner     string // frames of the spinner of the busy tabs, one character each
+	activity    string // mark of the monitored tabs with activity
+	silence     string // mark of the monitored tabs with silence
+}
+
+var (
+	unicodeGlyphs = glyphs{
+		close: "×", pointer: "›", ellipsis: "…", separator: " • ", left: "←", right: "→",
+		scrollLeft: "‹", scrollRight: "›", badge: "●",
+		spinner: "⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏", activity: "◆", silence: "◇",
+	}
+	asciiGlyphs = glyphs{
+		close: "x", pointer: ">", ellipsis: "...", separator: " | ", left: "left", right: "right",
+		scrollLeft: "<", scrollRight: ">", badge: "*",
+		spinner: `|/-\`, activity: "#", silence: "~",
+	}
+)
+
+// joinedBorder returns the border with the given joints on its left and right sides.
+func joinedBorder(border lipgloss.Border, left, right string) lipgloss.Border {
+	border.Left = left
+	border.Right = right
+	return border
+}
+
+// floatingJoints are the plain sides of the joints of the built-in border sets.
+var floatingJoints = map[string]string{
+	"┤": "│", "├": "│",
+	"╡": "│", "╞": "│",
+	"┥": "│", "┝": "│",
+	"┨": "┃", "┠": "┃",
+	"┫": "┃", "┣": "┃",
+	"╣": "║", "╠": "║",
+}
+
+// floatingBorder returns the border with plain sides instead of the joints, for the tabs that do not touch the frame line.
+// The sides of the custom border sets are kept if they are not one of the joints of the built-in sets.
+func floatingBorder(border lipgloss.Border) lipgloss.Border {
+	if side, ok := floatingJoints[border.Left]; ok {
+		border.Left = side
+	}
+	if side, ok := floatingJoints[border.Right]; ok {
+		border.Right = side
+	}
+	return border
+}
+
+// RoundedBorderSet returns the default border set, it has rounded corners and double bordered active tab.
+func RoundedBorderSet() BorderSet {
+	return BorderSet{
+		Name:      "Rounded",
+		Frame:     lipgloss.RoundedBorder(),
+		Tab:       joinedBorder(lipgloss.RoundedBorder(), "┤", "├"),
+		ActiveTab: joinedBorder(lipgloss.DoubleBorder(), "┤", "├"),
+	}
+}
+
+// SharpBorderSet returns the border set with square corners and thick bordered active tab.
+func SharpBorderSet() BorderSet {
+	return BorderSet{
+		Name:      "Sharp",
+		Frame:     lipgloss.NormalBorder(),
+		Tab:       joinedBorder(lipgloss.NormalBorder(), "┤", "├"),
+		ActiveTab: joinedBorder(lipgloss.ThickBorder(), "┨", "┠"),
+	}
+}
+
+// DoubleBorderSet returns the border set with double lined frame.
+func DoubleBorderSet() BorderSet {
+	return BorderSet{
+		Name:      "Double",
+		Frame:     lipgloss.DoubleBorder(),
+		Tab:       joinedBorder(lipgloss.NormalBorder(), "╡", "╞"),
+		ActiveTab: joinedBorder(lipgloss.DoubleBorder(), "╣", "╠"),
+	}
+}
+
+// ThickBorderSet returns the border set with thick lined frame.
+func ThickBorderSet() BorderSet {
+	return BorderSet{
+		Name:      "Thick",
+		Frame:     lipgloss.ThickBorder(),
+		Tab:       joinedBorder(lipgloss.NormalBorder(), "┥", "┝"),
+		ActiveTab: joinedBorder(lipgloss.ThickBorder(), "┫", "┣"),
+	}
+}
+
+// ASCIIBorderSet returns the border set drawn with the ASCII characters only.
+func ASCIIBorderSet() BorderSet {
+	border := lipgloss.Border{
+		Top: "-", Bottom: "-", Left: "|", Right: "|",
+		TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+	}
+	active := border
+	active.Top, active.Bottom = "=", "="
+
+	return BorderSet{
+		Name:      "ASCII",
+		Frame:     border,
+		Tab:       border,
+		ActiveTab: active,
+	}
+}
+
+// BorderSets returns the built-in border sets.
+func BorderSets() []BorderSet {
+	return []BorderSet{RoundedBorderSet(), SharpBorderSet(), DoubleBorderSet(), ThickBorderSet(), ASCIIBorderSet()}
+}
+
+// SetBorderSet sets the characters the frame, the tabs, the widgets and the overlays are drawn with.
+func (s *Skeleton) SetBorderSet(set BorderSet) *Skeleton {
+	s.properties.borderSet = set
+	s.applyBorderSet()
+	s.triggerUpdate()
+	return s
+}
+
+// GetBorderSet returns the border set, it is the one set by SetBorderSet even in ASCII-only mode.
+func (s *Skeleton) GetBorderSet() BorderSet {
+	return s.properties.borderSet
+}
+
+// SetASCIIOnly sets the Skeleton is drawn with the ASCII characters only or not.
+// It is useful for the serial consoles and the log captures that mangle the box-drawing characters.
+// The ASCII border set is used and the other symbols (×, ›, …) are replaced while it is enabled.
+func (s *Skeleton) SetASCIIOnly(ascii bool) *Skeleton {
+	s.properties.asciiOnly = ascii
+	s.applyBorderSet()
+	s.triggerUpdate()
+	return s
+}
+
+// IsASCIIOnly returns the Skeleton is drawn with the ASCII characters only or not.
+func (s *Skeleton) IsASCIIOnly() bool {
+	return s.properties.asciiOnly
+}
+
+// chromeBorderSet returns the border set and the glyphs the chrome is drawn with.
+func (s *Skeleton) chromeBorderSet() (BorderSet, glyphs) {
+	if s.properties.asciiOnly {
+		return ASCIIBorderSet(), asciiGlyphs
+	}
+	return s.properties.borderSet, unicodeGlyphs
+}
+
+// applyBorderSet applies the border set and the glyphs to the header, the widgets, the overlays and the key help.
+func (s *Skeleton) applyBorderSet() {
+	set, g := s.chromeBorderSet()
+
+	s.header.setBorderSet(set, g)
+	s.header.SetIconMode(s.chromeIconMode())
+	s.widget.setBorderSet(set, g)
+	s.overlay.setBorderSet(set, g)
+	s.keyHelp.setBorderSet(set, g)
+	s.KeyMap.rangeGlyph = g.ellipsis
+	s.focusKeyMap.setGlyphs(g)
+}
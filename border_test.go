@@ -0,0 +1,56 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// nonASCII returns the characters of the view outside the ASCII range.
+func nonASCII(view string) string {
+	var b strings.Builder
+	for _, r := range ansi.Strip(view) {
+		if r > unicode.MaxASCII {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func TestASCIIOnlyRendersASCIICharactersOnly(t *testing.T) {
+	var keys []string
+	for i := 0; i < 12; i++ {
+		keys = append(keys, fmt.Sprintf("page-%d", i))
+	}
+	s, _ := newTestSkeleton(keys...)
+	s.SetIconMode(IconUnicode)
+	s.SetPageBusy("page-9", true)
+	s.SetPageIcon("page-10", FolderIcon())
+	s.SetPageIcon("page-11", FileIcon())
+	s.SetPageBadge("page-11", "3", BadgeInfo)
+	s.ShowTabCloseButton(true)
+	s.ShowShortHelp(true)
+	s.Update(AddNewWidget{Key: "clock", Value: "12:00"})
+	s.Update(tea.WindowSizeMsg{Width: 60, Height: 12})
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	settle(s)
+
+	if chars := nonASCII(s.View()); !strings.Contains(chars, "▸") {
+		t.Fatal("the default view has no folder icon, want the icons of the visible tabs")
+	}
+
+	s.SetASCIIOnly(true)
+	settle(s)
+	if chars := nonASCII(s.View()); chars != "" {
+		t.Errorf("ASCII-only view has %q, want the ASCII characters only:\n%s", chars, ansi.Strip(s.View()))
+	}
+
+	s.SetSidebar(true)
+	settle(s)
+	if chars := nonASCII(s.View()); chars != "" {
+		t.Errorf("ASCII-only view with the sidebar has %q, want the ASCII characters only:\n%s", chars, ansi.Strip(s.View()))
+	}
+}
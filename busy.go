@@ -0,0 +1,83 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"time"
+)
+
+// busyTickInterval is the interval between the frames of the tab spinners.
+const busyTickInterval = 100 * time.Millisecond
+
+// busyTickMsg is sent to advance the spinners of the busy tabs.
+type busyTickMsg struct{}
+
+// UpdatePageBusy sets the page by the given key is busy or not.
+type UpdatePageBusy struct {
+	// Key is unique key of the page, it is used to identify the page
+	Key string
+
+	// Busy is the page is busy or not
+	Busy bool
+}
+
+// UpdatePageProgress sets the progress of the page by the given key.
+type UpdatePageProgress struct {
+	// Key is unique key of the page, it is used to identify the page
+	Key string
+
+	// Progress is between 0 and 1, negative progress removes it
+	Progress float64
+}
+
+// SetPageBusy sets the page by the given key is busy or not, a spinner is rendered on the tab of the busy pages.
+// The spinner replaces the icon of the tab. It is animated by the Skeleton, the page does not need to be active.
+func (s *Skeleton) SetPageBusy(key string, busy bool) *Skeleton {
+	go func() {
+		s.updateChan <- UpdatePageBusy{
+			Key:  key,
+			Busy: busy,
+		}
+	}()
+	return s
+}
+
+// IsPageBusy returns the page by the given key is busy or not.
+func (s *Skeleton) IsPageBusy(key string) bool {
+	return s.header.IsBusy(key)
+}
+
+// SetPageProgress sets the progress of the page by the given key, it is rendered after the title as a percentage.
+// The progress is between 0 and 1, negative progress removes it and NaN is ignored.
+func (s *Skeleton) SetPageProgress(key string, progress float64) *Skeleton {
+	go func() {
+		s.updateChan <- UpdatePageProgress{
+			Key:      key,
+			Progress: progress,
+		}
+	}()
+	return s
+}
+
+// GetPageProgress returns the progress of the page by the given key, ok is false if the page has no progress.
+func (s *Skeleton) GetPageProgress(key string) (progress float64, ok bool) {
+	return s.header.GetProgress(key)
+}
+
+// scheduleBusyTick starts the tick of the spinners if a page is busy and the tick is not running.
+func (s *Skeleton) scheduleBusyTick(cmds []tea.Cmd) []tea.Cmd {
+	if s.busyTicking || !s.header.HasBusy() {
+		return cmds
+	}
+
+	s.busyTicking = true
+	return append(cmds, tea.Tick(busyTickInterval, func(time.Time) tea.Msg {
+		return busyTickMsg{}
+	}))
+}
+
+// updateBusyTick advances the spinners, the tick stops when no page is busy.
+func (s *Skeleton) updateBusyTick(cmds []tea.Cmd) []tea.Cmd {
+	s.busyTicking = false
+	s.header.AdvanceSpinner()
+	return s.scheduleBusyTick(cmds)
+}
@@ -0,0 +1,70 @@
+package skeleton
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestUpdatePageProgress(t *testing.T) {
+	s, _ := newTestSkeleton("build")
+
+	tests := []struct {
+		name     string
+		progress float64
+		want     float64
+		ok       bool
+	}{
+		{"progress", 0.5, 0.5, true},
+		{"nan is ignored", math.NaN(), 0.5, true},
+		{"clamped", 1.5, 1, true},
+		{"negative removes it", -1, -1, false},
+	}
+	for _, tt := range tests {
+		s.Update(UpdatePageProgress{Key: "build", Progress: tt.progress})
+		if progress, ok := s.GetPageProgress("build"); progress != tt.want || ok != tt.ok {
+			t.Errorf("%s: progress = %v, %v, want %v, %v", tt.name, progress, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestUpdatePageBusy(t *testing.T) {
+	s, _ := newTestSkeleton("build")
+
+	s.Update(UpdatePageBusy{Key: "build", Busy: true})
+	if !s.IsPageBusy("build") || !s.busyTicking {
+		t.Fatal("the page is not busy or its spinner is not animated")
+	}
+	s.Update(UpdatePageBusy{Key: "build", Busy: false})
+	if s.IsPageBusy("build") {
+		t.Error("the page is still busy")
+	}
+}
+
+func TestBusyTickStopsWithoutBusyPages(t *testing.T) {
+	s, _ := newTestSkeleton("build", "test")
+	s.Update(UpdatePageBusy{Key: "test", Busy: true})
+
+	frame := s.header.spinner()
+	s.Update(busyTickMsg{})
+	if s.header.spinner() == frame || !s.busyTicking {
+		t.Fatal("the tick does not advance the spinner or stops while a page is busy")
+	}
+
+	s.Update(UpdatePageBusy{Key: "test", Busy: false})
+	s.Update(busyTickMsg{})
+	if s.busyTicking {
+		t.Error("the tick is scheduled again without a busy page")
+	}
+}
+
+func TestBusyTabTitle(t *testing.T) {
+	s, _ := newTestSkeleton("build")
+	s.Update(UpdatePageBusy{Key: "build", Busy: true})
+	s.Update(UpdatePageProgress{Key: "build", Progress: 0.42})
+
+	title := s.header.displayTitle(0, s.header.headers[0])
+	if !strings.HasPrefix(title, s.header.spinner()+" ") || !strings.Contains(title, "42%") {
+		t.Errorf("title = %q, want the spinner and the progress", title)
+	}
+}
@@ -0,0 +1,76 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"os"
+	"strconv"
+	"sync"
+)
+
+var (
+	onceMonochromeRenderer sync.Once
+	varMonochromeRenderer  *lipgloss.Renderer
+)
+
+// monochromeRenderer returns the renderer of the text attributes (bold, faint, reverse) without any color.
+// The default renderer drops the attributes as well when the colors are unavailable, so the chrome uses this one in monochrome mode.
+// It is created on the first use, the colors of the terminal are not queried before the chrome is rendered.
+func monochromeRenderer() *lipgloss.Renderer {
+	onceMonochromeRenderer.Do(func() {
+		varMonochromeRenderer = lipgloss.NewRenderer(os.Stdout)
+		varMonochromeRenderer.SetColorProfile(termenv.ANSI)
+	})
+	return varMonochromeRenderer
+}
+
+// isColorless returns the colors are unavailable or not.
+// The color profile of the terminal is detected, NO_COLOR (https://no-color.org) disables the colors as well.
+func isColorless() bool {
+	return os.Getenv("NO_COLOR") != "" || lipgloss.ColorProfile() == termenv.Ascii
+}
+
+// chromeRenderer returns the renderer of the chrome styles by the given mode.
+func chromeRenderer(monochrome bool) *lipgloss.Renderer {
+	if monochrome {
+		return monochromeRenderer()
+	}
+	return lipgloss.DefaultRenderer()
+}
+
+// colorString returns the color as text, the adaptive colors are resolved by the given background of the terminal.
+func colorString(color lipgloss.TerminalColor, darkBackground bool) string {
+	switch c := color.(type) {
+	case lipgloss.Color:
+		return string(c)
+	case lipgloss.ANSIColor:
+		return strconv.Itoa(int(c))
+	case lipgloss.AdaptiveColor:
+		if darkBackground {
+			return c.Dark
+		}
+		return c.Light
+	case lipgloss.CompleteColor:
+		return c.TrueColor
+	case lipgloss.CompleteAdaptiveColor:
+		if darkBackground {
+			return c.Dark.TrueColor
+		}
+		return c.Light.TrueColor
+	}
+	return ""
+}
+
+// SetMonochrome sets the chrome is rendered with the text attributes (bold, faint, reverse) instead of the colors or not.
+// It is enabled by default when NO_COLOR is set or the terminal does not support colors.
+func (s *Skeleton) SetMonochrome(monochrome bool) *Skeleton {
+	s.properties.monochrome = monochrome
+	s.applyTheme()
+	s.triggerUpdate()
+	return s
+}
+
+// IsMonochrome returns the chrome is rendered without colors or not.
+func (s *Skeleton) IsMonochrome() bool {
+	return s.properties.monochrome
+}
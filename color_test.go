@@ -0,0 +1,56 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"testing"
+)
+
+func TestColorString(t *testing.T) {
+	adaptive := lipgloss.AdaptiveColor{Light: "236", Dark: "255"}
+	completeAdaptive := lipgloss.CompleteAdaptiveColor{
+		Light: lipgloss.CompleteColor{TrueColor: "#111111"},
+		Dark:  lipgloss.CompleteColor{TrueColor: "#EEEEEE"},
+	}
+
+	tests := []struct {
+		name  string
+		color lipgloss.TerminalColor
+		dark  bool
+		want  string
+	}{
+		{"color", lipgloss.Color("#7D56F4"), false, "#7D56F4"},
+		{"ansi", lipgloss.ANSIColor(9), false, "9"},
+		{"adaptive on light", adaptive, false, "236"},
+		{"adaptive on dark", adaptive, true, "255"},
+		{"complete", lipgloss.CompleteColor{TrueColor: "#04B575", ANSI256: "35"}, false, "#04B575"},
+		{"complete adaptive on light", completeAdaptive, false, "#111111"},
+		{"complete adaptive on dark", completeAdaptive, true, "#EEEEEE"},
+		{"no color", nil, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := colorString(tt.color, tt.dark); got != tt.want {
+				t.Errorf("colorString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdaptiveBorderColorIsResolvedByTheTheme(t *testing.T) {
+	s := NewSkeleton().SetMonochrome(false)
+	theme := DefaultTheme()
+	theme.Border = lipgloss.AdaptiveColor{Light: "236", Dark: "255"}
+	s.SetTheme(theme)
+
+	want := "236"
+	if s.properties.darkBackground {
+		want = "255"
+	}
+	if got := s.GetBorderColor(); got != want {
+		t.Errorf("border color = %q, want %q by the background resolved by NewSkeleton", got, want)
+	}
+	if got := s.GetWidgetBorderColor(); got != want {
+		t.Errorf("widget border color = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,223 @@
+package skeleton
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"strings"
+)
+
+// command is hold the command required fields.
+type command struct {
+	// name is the name of the command, it is shown on the command palette
+	name string
+
+	// description is the description of the command, it is shown next to the name
+	description string
+
+	// binding is the key binding that runs the command without opening the palette
+	binding key.Binding
+
+	// run is called when the command is selected or its key binding is pressed
+	run func() tea.Cmd
+
+	// page is the key of the page that owns the command, it is empty for global commands
+	page string
+}
+
+// RegisterCommand registers a global command. It is listed on the command palette and runs when its binding is pressed.
+// Registering a command with an existing name replaces the old one.
+func (s *Skeleton) RegisterCommand(name string, description string, binding key.Binding, run func() tea.Cmd) *Skeleton {
+	return s.RegisterPageCommand("", name, description, binding, run)
+}
+
+// RegisterPageCommand registers a command that belongs to the page by the given key.
+// The command is listed on the command palette and its binding works only while the page is active.
+func (s *Skeleton) RegisterPageCommand(pageKey string, name string, description string, binding key.Binding, run func() tea.Cmd) *Skeleton {
+	cmd := &command{
+		name:        name,
+		description: description,
+		binding:     binding,
+		run:         run,
+		page:        pageKey,
+	}
+
+	for i, c := range s.commands {
+		if c.page == pageKey && c.name == name {
+			s.commands[i] = cmd
+			return s
+		}
+	}
+
+	s.commands = append(s.commands, cmd)
+	return s
+}
+
+// UnregisterCommand unregisters the global command by the given name.
+func (s *Skeleton) UnregisterCommand(name string) *Skeleton {
+	return s.UnregisterPageCommand("", name)
+}
+
+// UnregisterPageCommand unregisters the command of the page by the given page key and name.
+func (s *Skeleton) UnregisterPageCommand(pageKey string, name string) *Skeleton {
+	for i, c := range s.commands {
+		if c.page == pageKey && c.name == name {
+			s.commands = append(s.commands[:i], s.commands[i+1:]...)
+			break
+		}
+	}
+	return s
+}
+
+// deletePageCommands deletes the commands of the page by the given key.
+func (s *Skeleton) deletePageCommands(pageKey string) {
+	var commands []*command
+	for _, c := range s.commands {
+		if c.page != pageKey {
+			commands = append(commands, c)
+		}
+	}
+	s.commands = commands
+}
+
+// activeCommands returns the global commands and the commands of the active page.
+func (s *Skeleton) activeCommands() []*command {
+	activePage := s.GetActivePage()
+
+	var commands []*command
+	for _, c := range s.commands {
+		if c.page == "" || c.page == activePage {
+			commands = append(commands, c)
+		}
+	}
+	return commands
+}
+
+// runCommandBinding runs the active command bound to the given key or key sequence, it returns false if there is no such command.
+func (s *Skeleton) runCommandBinding(keys string) (tea.Cmd, bool) {
+	for _, c := range s.activeCommands() {
+		if c.run != nil && s.KeyMap.matches(keys, c.binding) {
+			return c.run(), true
+		}
+	}
+	return nil, false
+}
+
+// builtinCommands returns the commands shipped with the Skeleton.
+func (s *Skeleton) builtinCommands() []*command {
+	var commands []*command
+
+	if !s.IsTabsLocked() {
+		for _, hdr := range s.header.headers {
+			pageKey := hdr.key
+			commands = append(commands, &command{
+				name:        fmt.Sprintf("Switch to %s", hdr.title),
+				description: pageKey,
+				run: func() tea.Cmd {
+					s.SetActivePage(pageKey)
+					return s.IAMActivePageCmd()
+				},
+			})
+		}
+	}
+
+	if s.canCloseActivePage() {
+		commands = append(commands, &command{
+			name:        "Close page",
+			description: "close the current page",
+			run: func() tea.Cmd {
+				return tea.Batch(s.closeActivePage(nil)...)
+			},
+		})
+	}
+
+	if s.IsTabsLocked() {
+		commands = append(commands, &command{
+			name:        "Unlock tabs",
+			description: "allow switching tabs",
+			run: func() tea.Cmd {
+				s.UnlockTabs()
+				return nil
+			},
+		})
+	} else {
+		commands = append(commands, &command{
+			name:        "Lock tabs",
+			description: "prevent switching tabs",
+			run: func() tea.Cmd {
+				s.LockTabs()
+				return nil
+			},
+		})
+	}
+
+	return append(commands, s.themeCommands()...)
+}
+
+// OpenCommandPalette opens the command palette, it filters the built-in commands,
+// the global commands and the commands of the active page by their names and descriptions.
+func (s *Skeleton) OpenCommandPalette() *Skeleton {
+	commands := append(s.activeCommands(), s.builtinCommands()...)
+
+	items := make([]overlayItem, len(commands))
+	for i, c := range commands {
+		items[i] = overlayItem{
+			title:       c.name,
+			description: c.description,
+			run:         c.run,
+		}
+		if c.binding.Enabled() {
+			items[i].detail = strings.Join(c.binding.Keys(), "/")
+		}
+	}
+
+	s.keyHelp.Close()
+	s.overlay.Open("Command", items)
+	s.triggerUpdate()
+	return s
+}
+
+// commandBindings returns the enabled key bindings of the commands owned by the given page key with their names.
+// Global commands are owned by the empty page key.
+func (s *Skeleton) commandBindings(pageKey string) []describedBinding {
+	var bindings []describedBinding
+	for _, c := range s.commands {
+		if c.page == pageKey && c.binding.Enabled() {
+			bindings = append(bindings, describedBinding{binding: c.binding, description: c.name})
+		}
+	}
+	return bindings
+}
+
+// pageBindings returns the key bindings of the page by the given index.
+// They are the key bindings of the page commands and, if the page implements help.KeyMap, the key bindings of the page.
+func (s *Skeleton) pageBindings(index int) []describedBinding {
+	bindings := s.commandBindings(s.header.headers[index].key)
+
+	keyMap, ok := s.pages[index].(help.KeyMap)
+	if !ok {
+		return bindings
+	}
+	for _, group := range keyMap.FullHelp() {
+		for _, binding := range group {
+			if binding.Enabled() {
+				bindings = append(bindings, describedBinding{binding: binding, description: binding.Help().Desc})
+			}
+		}
+	}
+	return bindings
+}
+
+// KeyConflicts returns the keys that are bound by a global key binding (built-in or global command)
+// and a key binding of a page (page command or help.KeyMap of the page) at the same time.
+// On a conflict the global key binding runs first, and a global key sequence holds its first key back from the page.
+func (s *Skeleton) KeyConflicts() []KeyConflict {
+	global := append(s.KeyMap.describedBindings(), s.commandBindings("")...)
+
+	var conflicts []KeyConflict
+	for i, hdr := range s.header.headers {
+		conflicts = append(conflicts, findKeyConflicts(hdr.key, global, s.pageBindings(i))...)
+	}
+	return conflicts
+}
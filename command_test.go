@@ -0,0 +1,88 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+// commandNames returns the names of the commands.
+func commandNames(commands []*command) []string {
+	var names []string
+	for _, c := range commands {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func TestPageCommandVisibility(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second")
+	var runs []string
+	s.RegisterCommand("Reload", "", key.NewBinding(key.WithKeys("ctrl+r")), func() tea.Cmd {
+		runs = append(runs, "reload")
+		return nil
+	})
+	s.RegisterPageCommand("second", "Filter", "", key.NewBinding(key.WithKeys("ctrl+t")), func() tea.Cmd {
+		runs = append(runs, "filter")
+		return nil
+	})
+
+	if got := commandNames(s.activeCommands()); len(got) != 1 || got[0] != "Reload" {
+		t.Errorf("commands on the first page = %q, want the global command only", got)
+	}
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if len(runs) != 0 {
+		t.Errorf("runs = %q, want the page command to wait for its page", runs)
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlRight})
+	if got := commandNames(s.activeCommands()); len(got) != 2 {
+		t.Errorf("commands on the second page = %q, want the global and the page command", got)
+	}
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if len(runs) != 2 || runs[0] != "filter" || runs[1] != "reload" {
+		t.Errorf("runs = %q, want the page and the global command", runs)
+	}
+}
+
+func TestRegisterCommandReplacesTheSameName(t *testing.T) {
+	s, _ := newTestSkeleton("first")
+	var run string
+	s.RegisterCommand("Reload", "old", key.Binding{}, func() tea.Cmd { run = "old"; return nil })
+	s.RegisterPageCommand("first", "Reload", "page", key.Binding{}, nil)
+	s.RegisterCommand("Reload", "new", key.Binding{}, func() tea.Cmd { run = "new"; return nil })
+
+	if len(s.commands) != 2 {
+		t.Fatalf("commands = %q, want the global command replaced and the page command kept", commandNames(s.commands))
+	}
+	if s.commands[0].description != "new" || s.commands[0].page != "" {
+		t.Errorf("first command = %+v, want the replacement in place of the old one", s.commands[0])
+	}
+	s.commands[0].run()
+	if run != "new" {
+		t.Errorf("run = %q, want the replacement", run)
+	}
+}
+
+func TestUnregisterCommand(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second")
+	s.RegisterCommand("Reload", "", key.Binding{}, nil)
+	s.RegisterPageCommand("first", "Reload", "", key.Binding{}, nil)
+	s.RegisterPageCommand("second", "Filter", "", key.Binding{}, nil)
+
+	s.UnregisterCommand("Reload")
+	if got := commandNames(s.commands); len(got) != 2 || s.commands[0].page != "first" {
+		t.Errorf("commands = %q, want the global command removed only", got)
+	}
+	s.UnregisterPageCommand("first", "Missing")
+	s.UnregisterPageCommand("first", "Reload")
+	if got := commandNames(s.commands); len(got) != 1 || got[0] != "Filter" {
+		t.Errorf("commands = %q, want the page command removed", got)
+	}
+
+	s.Update(DeletePage{Key: "second"})
+	if len(s.commands) != 0 {
+		t.Errorf("commands = %q, want the commands of the deleted page removed", commandNames(s.commands))
+	}
+}
@@ -0,0 +1,49 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ContentSizeMsg is sent to the pages when the size of the page content changes, e.g. the terminal is resized,
+// the tabs wrap onto more rows or the first widget appears. It is the area inside the frame, the sidebar,
+// and the margins and the padding of the frame style, the pages fit their views into it.
+type ContentSizeMsg struct {
+	Width  int
+	Height int
+}
+
+// GetContentSize returns the size of the page content computed from the current layout.
+// The pages receive the same size by ContentSizeMsg whenever it changes.
+func (s *Skeleton) GetContentSize() (width, height int) {
+	return s.contentSize()
+}
+
+// contentSize returns the size of the area inside the frame, the sidebar, and the margins and the padding of the frame style.
+// It is the whole terminal but the row of the zoom hint while the active page is zoomed.
+func (s *Skeleton) contentSize() (width, height int) {
+	if s.zoomed {
+		return s.viewport.Width, max(s.viewport.Height-s.zoomHintHeight(), 0)
+	}
+
+	frameStyle := s.properties.frameStyle
+	width = s.viewport.Width - 2 - s.sidebarWidth() - frameStyle.GetHorizontalMargins() - frameStyle.GetHorizontalPadding()
+	height = s.bodyHeight() - frameStyle.GetVerticalMargins() - frameStyle.GetVerticalPadding()
+	return max(width, 0), max(height, 0)
+}
+
+// updateContentSize sends ContentSizeMsg to all the pages if the size of the page content is changed since the last one.
+func (s *Skeleton) updateContentSize(cmds []tea.Cmd) []tea.Cmd {
+	width, height := s.contentSize()
+	msg := ContentSizeMsg{Width: width, Height: height}
+	if !s.termReady || msg == s.contentSizeSent {
+		return cmds
+	}
+
+	s.contentSizeSent = msg
+	for i := range s.pages {
+		var cmd tea.Cmd
+		s.pages[i], cmd = s.pages[i].Update(msg)
+		cmds = append(cmds, cmd)
+	}
+	return cmds
+}
@@ -43,8 +43,10 @@ func (e *explorer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case skeleton.IAMActivePage:
 		e.InitializeWidgets()
-	case tea.WindowSizeMsg:
-		e.picker.Height = msg.Height - 7
+	case skeleton.ContentSizeMsg:
+		// the picker renders an empty line after the files, and fits its files into the height on the window size
+		e.picker.Height = msg.Height - 1
+		e.picker, _ = e.picker.Update(tea.WindowSizeMsg{Width: msg.Width, Height: msg.Height})
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q":
@@ -2,12 +2,11 @@ package main
 
 import (
 	"fmt"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/termkit/skeleton"
 	"os"
-	"strings"
 )
 
 type fileReader struct {
@@ -16,6 +15,19 @@ type fileReader struct {
 	fileName string
 }
 
+// ShortHelp returns the key bindings of the file reader, skeleton shows them on the short help row.
+func (m *fileReader) ShortHelp() []key.Binding {
+	return []key.Binding{m.viewport.KeyMap.Up, m.viewport.KeyMap.Down}
+}
+
+// FullHelp returns the key bindings of the file reader, skeleton shows them on the help overlay.
+func (m *fileReader) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{m.viewport.KeyMap.Up, m.viewport.KeyMap.Down},
+		{m.viewport.KeyMap.PageUp, m.viewport.KeyMap.PageDown},
+	}
+}
+
 func (m *fileReader) Init() tea.Cmd {
 	return nil
 }
@@ -31,16 +43,11 @@ func (m *fileReader) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case skeleton.IAMActivePage:
 		m.skeleton.DeleteAllWidgets()
 		m.CalculatePercent()
-	case tea.WindowSizeMsg:
-		m.viewport.Height = msg.Height - 10
-		m.viewport.Width = msg.Width - 2
+	case skeleton.ContentSizeMsg:
+		m.viewport.Height = msg.Height
+		m.viewport.Width = msg.Width
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+w":
-			m.skeleton.DeletePage(m.fileName)
-		default:
-			m.CalculatePercent()
-		}
+		m.CalculatePercent()
 	}
 
 	m.viewport, _ = m.viewport.Update(msg)
@@ -49,17 +56,7 @@ func (m *fileReader) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *fileReader) View() string {
-	var b strings.Builder
-	b.WriteString(m.viewport.View())
-	b.WriteString("\n\n\n")
-
-	helperWindow := lipgloss.NewStyle().UnsetBorderStyle().Foreground(lipgloss.Color("#00ffff"))
-	helper := helperWindow.Render(fmt.Sprintf("%s | %s Switch Tab - %s close tab",
-		m.skeleton.KeyMap.SwitchTabLeft.Keys(),
-		m.skeleton.KeyMap.SwitchTabRight.Keys(), "ctrl+w"))
-
-	b.WriteString(helper)
-	return b.String()
+	return m.viewport.View()
 }
 
 func newFileReader(skeleton *skeleton.Skeleton, fileName string, filePath string) *fileReader {
@@ -69,7 +66,7 @@ func newFileReader(skeleton *skeleton.Skeleton, fileName string, filePath string
 		os.Exit(1)
 	}
 
-	vp := viewport.Model{Width: skeleton.GetTerminalWidth() - 2, Height: skeleton.GetTerminalHeight() - 10}
+	vp := viewport.New(skeleton.GetContentSize())
 	vp.SetContent(string(content))
 
 	return &fileReader{
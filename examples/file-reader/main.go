@@ -12,10 +12,13 @@ func main() {
 
 	s.SetBorderColor("#ff0055")
 	s.SetActiveTabBorderColor("#00aaff")
+	s.ShowShortHelp(true)
 
 	s.AddPage("explorer", "Explorer", newExplorer(s))
+	s.SetPagePinned("explorer", true) // explorer can not be closed with ctrl+w
+	s.ShowTabCloseButton(true)        // file tabs can be closed by clicking ×
 
-	if err := tea.NewProgram(s).Start(); err != nil {
+	if err := tea.NewProgram(s, tea.WithMouseCellMotion()).Start(); err != nil {
 		panic(err)
 	}
 }
@@ -67,7 +67,7 @@ func main() {
 		}
 	}()
 
-	p := tea.NewProgram(s)
+	p := tea.NewProgram(s, tea.WithMouseCellMotion())
 	if err := p.Start(); err != nil {
 		panic(err)
 	}
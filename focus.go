@@ -0,0 +1,154 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Focus is the area of the Skeleton that receives the key presses.
+type Focus int
+
+const (
+	// FocusPage is the default focus, the key presses go to the active page.
+	FocusPage Focus = iota
+
+	// FocusTabBar means the arrow keys switch the tabs and enter returns the focus to the page.
+	FocusTabBar
+
+	// FocusStatusBar means the arrow keys select the widgets and enter returns the focus to the page.
+	FocusStatusBar
+)
+
+// ExclusiveInput is an optional interface for the pages.
+// While ExclusiveInput returns true, the active page receives every key press and the global key bindings,
+// except quit, are suspended. It is useful while a text input of the page is focused.
+type ExclusiveInput interface {
+	ExclusiveInput() bool
+}
+
+// focusKeyMap is hold the key bindings used while the tab bar or the status bar is focused.
+type focusKeyMap struct {
+	Prev  key.Binding
+	Next  key.Binding
+	First key.Binding
+	Last  key.Binding
+	Back  key.Binding
+}
+
+// newFocusKeyMap returns the key bindings used while the tab bar or the status bar is focused.
+func newFocusKeyMap() *focusKeyMap {
+	return &focusKeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "previous"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "next"),
+		),
+		First: key.NewBinding(
+			key.WithKeys("home"),
+			key.WithHelp("home", "first"),
+		),
+		Last: key.NewBinding(
+			key.WithKeys("end"),
+			key.WithHelp("end", "last"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("enter", "esc"),
+			key.WithHelp("enter/esc", "back to page"),
+		),
+	}
+}
+
+// setGlyphs sets the arrows on the help of the key bindings by the given glyphs.
+func (k *focusKeyMap) setGlyphs(g glyphs) {
+	k.Prev.SetHelp(g.left+"/h", k.Prev.Help().Desc)
+	k.Next.SetHelp(g.right+"/l", k.Next.Help().Desc)
+}
+
+// ShortHelp returns the key bindings used while the tab bar or the status bar is focused, it implements help.KeyMap.
+func (k *focusKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Prev, k.Next, k.Back}
+}
+
+// FullHelp returns the key bindings used while the tab bar or the status bar is focused, it implements help.KeyMap.
+func (k *focusKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Prev, k.Next}, {k.First, k.Last, k.Back}}
+}
+
+// SetFocus sets the area that receives the key presses.
+// The status bar can not be focused while there is no widget, the page is focused instead.
+func (s *Skeleton) SetFocus(focus Focus) *Skeleton {
+	if focus == FocusStatusBar && len(s.widget.widgets) == 0 {
+		focus = FocusPage
+	}
+
+	s.focus = focus
+	s.header.SetFocused(focus == FocusTabBar)
+	if focus == FocusStatusBar {
+		s.widget.SetFocused(max(s.widget.GetFocused(), 0))
+	} else {
+		s.widget.SetFocused(-1)
+	}
+
+	s.triggerUpdate()
+	return s
+}
+
+// GetFocus returns the area that receives the key presses.
+func (s *Skeleton) GetFocus() Focus {
+	return s.focus
+}
+
+// cycleFocus moves the focus from the page to the tab bar, to the status bar and back to the page.
+func (s *Skeleton) cycleFocus() {
+	if s.zoomed {
+		return // the tab bar and the status bar are hidden
+	}
+	switch s.focus {
+	case FocusPage:
+		s.SetFocus(FocusTabBar)
+	case FocusTabBar:
+		s.SetFocus(FocusStatusBar)
+	default:
+		s.SetFocus(FocusPage)
+	}
+}
+
+// pageClaimsInput returns the active page claims the key presses exclusively or not.
+func (s *Skeleton) pageClaimsInput() bool {
+	page, ok := s.pages[s.currentTab].(ExclusiveInput)
+	return ok && s.focus == FocusPage && page.ExclusiveInput()
+}
+
+// updateFocusKey handles the key press while the tab bar or the status bar is focused.
+// It returns false if the key is not a focus navigation key.
+func (s *Skeleton) updateFocusKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	var cmds []tea.Cmd
+
+	switch {
+	case key.Matches(msg, s.focusKeyMap.Back):
+		s.SetFocus(FocusPage)
+	case s.focus == FocusTabBar && key.Matches(msg, s.focusKeyMap.Prev):
+		cmds = s.switchPage(cmds, "left")
+	case s.focus == FocusTabBar && key.Matches(msg, s.focusKeyMap.Next):
+		cmds = s.switchPage(cmds, "right")
+	case s.focus == FocusTabBar && key.Matches(msg, s.focusKeyMap.First):
+		cmds = s.jumpToPage(cmds, 0)
+	case s.focus == FocusTabBar && key.Matches(msg, s.focusKeyMap.Last):
+		cmds = s.jumpToPage(cmds, len(s.pages)-1)
+	case s.focus == FocusStatusBar && key.Matches(msg, s.focusKeyMap.Prev):
+		s.widget.MoveFocus(-1)
+	case s.focus == FocusStatusBar && key.Matches(msg, s.focusKeyMap.Next):
+		s.widget.MoveFocus(1)
+	case s.focus == FocusStatusBar && key.Matches(msg, s.focusKeyMap.First):
+		s.widget.SetFocused(0)
+	case s.focus == FocusStatusBar && key.Matches(msg, s.focusKeyMap.Last):
+		s.widget.SetFocused(len(s.widget.widgets) - 1)
+	default:
+		return nil, false
+	}
+
+	return tea.Batch(cmds...), true
+}
@@ -0,0 +1,73 @@
+package skeleton
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// fuzzyMatch reports whether every rune of the pattern appears in the target in the same order.
+// It also returns a score, higher is better. Consecutive runes and runes at the start of a word are rewarded.
+func fuzzyMatch(pattern, target string) (int, bool) {
+	if pattern == "" {
+		return 0, true
+	}
+
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(target)
+
+	var score, pi, streak int
+	for ti := 0; ti < len(t) && pi < len(p); ti++ {
+		if unicode.ToLower(t[ti]) != p[pi] {
+			streak = 0
+			continue
+		}
+
+		score++
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score += 3 // start of a word
+		}
+		streak++
+		score += streak // consecutive runes
+		pi++
+	}
+
+	if pi < len(p) {
+		return 0, false
+	}
+
+	return score - len(t)/8, true // shorter targets are slightly better
+}
+
+// fuzzyRank is hold the match score of the candidate at the given index.
+type fuzzyRank struct {
+	index int
+	score int
+}
+
+// fuzzyFilter returns the indexes of the candidates matching the pattern, best match first.
+// A candidate matches if any of its fields matches.
+func fuzzyFilter(pattern string, candidates [][]string) []int {
+	var ranks []fuzzyRank
+	for i, fields := range candidates {
+		best, matched := 0, false
+		for _, field := range fields {
+			if score, ok := fuzzyMatch(pattern, field); ok && (!matched || score > best) {
+				best, matched = score, true
+			}
+		}
+		if matched {
+			ranks = append(ranks, fuzzyRank{index: i, score: best})
+		}
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		return ranks[i].score > ranks[j].score
+	})
+
+	indexes := make([]int, len(ranks))
+	for i, rank := range ranks {
+		indexes[i] = rank.index
+	}
+	return indexes
+}
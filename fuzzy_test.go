@@ -0,0 +1,78 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		target  string
+		ok      bool
+	}{
+		{"", "Logs", true},
+		{"lg", "Logs", true},
+		{"LOGS", "logs", true},
+		{"gl", "Logs", false},
+		{"logx", "Logs", false},
+		{"ü", "Günlük", true},
+	}
+
+	for _, tt := range tests {
+		if _, ok := fuzzyMatch(tt.pattern, tt.target); ok != tt.ok {
+			t.Errorf("fuzzyMatch(%q, %q) = %v, want %v", tt.pattern, tt.target, ok, tt.ok)
+		}
+	}
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	candidates := [][]string{{"Settings"}, {"Blog"}, {"Log viewer"}, {"Logs"}, {"Build", "logs"}}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []int
+	}{
+		{"empty query keeps the order", "", []int{0, 1, 2, 3, 4}},
+		{"word start and shorter targets first", "log", []int{3, 4, 2, 1}},
+		{"ties keep the order", "s", []int{0, 3, 4}},
+		{"no match", "xyz", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fuzzyFilter(tt.pattern, candidates)
+			if len(got) != len(tt.want) {
+				t.Fatalf("fuzzyFilter(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("fuzzyFilter(%q) = %v, want %v", tt.pattern, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTabSearch(t *testing.T) {
+	s, _ := newTestSkeleton("logs", "build", "blog")
+	s.ShowTabIndex(true)
+	if title := s.header.displayTitle(1, s.header.headers[1]); title != "2 build" {
+		t.Errorf("title = %q, want the index prefix", title)
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	for _, r := range "bld" {
+		s.Update(runes(string(r)))
+	}
+	s.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if s.GetActivePage() != "build" {
+		t.Errorf("active page = %q, want the best match of the search", s.GetActivePage())
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if len(s.overlay.matches) != 3 {
+		t.Errorf("matches = %v, want every tab for the empty query", s.overlay.matches)
+	}
+}
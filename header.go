@@ -1,13 +1,18 @@
 package skeleton
 
 import (
-	"github.com/charmbracelet/bubbles/key"
+	"fmt"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"math"
 	"strings"
 )
 
+// headerHeight is the height of the tab bar and the status bar with widgets.
+const headerHeight = 3
+
 // header is a helper for rendering the header of the terminal.
 type header struct {
 	// termReady is control terminal is ready or not, it responsible for the terminal size
@@ -19,12 +24,12 @@ type header struct {
 	// currentTab is hold the current tab index
 	currentTab int
 
+	// focused is control the header (tab bar) has the focus or not
+	focused bool
+
 	// viewport is hold the viewport, it is responsible for the terminal size
 	viewport *viewport.Model
 
-	// keyMap responsible for the key bindings
-	keyMap *keyMap
-
 	// headers are hold the headers of the terminal
 	headers []commonHeader
 
@@ -34,6 +39,39 @@ type header struct {
 	// titleLength is hold the length of the title
 	titleLength int
 
+	// offset is hold the index of the first visible tab, the tabs scroll when they do not fit
+	offset int
+
+	// visibleEnd is hold the index after the last visible tab
+	visibleEnd int
+
+	// spinnerFrame is hold the frame of the spinners of the busy tabs
+	spinnerFrame int
+
+	// rows are hold the index of the first tab of each row while the tabs wrap
+	rows []int
+
+	// widgets are hold the widgets on the right side of the header, e.g. a clock or the environment name
+	widgets []*commonWidget
+
+	// widgetOffset is hold the index of the first visible widget, the first widgets are hidden when the current tab does not fit
+	widgetOffset int
+
+	// sidebarOffset is hold the index of the first visible tab on the sidebar
+	sidebarOffset int
+
+	// sidebarEnd is hold the index after the last visible tab on the sidebar
+	sidebarEnd int
+
+	// sidebarHeight is hold the height of the sidebar, it is the height of the page area
+	sidebarHeight int
+
+	// clickHandlers are hold the callbacks of the widgets of the header by their keys, they are called when the widget is clicked
+	clickHandlers map[string]func() tea.Cmd
+
+	// statusBar is hold the status bar, the widgets of the header are rendered with its renderer and its widget style
+	statusBar *widget
+
 	// updateChan is hold the update channel
 	updateChan chan any
 }
@@ -44,61 +82,99 @@ func newHeader() *header {
 		properties: defaultHeaderProperties(),
 		viewport:   newTerminalViewport(),
 		currentTab: 0,
-		keyMap:     newKeyMap(),
+		statusBar:  newWidget(),
 		updateChan: make(chan any),
+
+		clickHandlers: make(map[string]func() tea.Cmd),
 	}
 }
 
 // headerProperties are hold the properties of the header.
 type headerProperties struct {
-	borderColor        string
-	leftTabPadding     int
-	rightTabPadding    int
+	borderColor        lipgloss.TerminalColor
+	borderSet          BorderSet
+	glyphs             glyphs
+	monochrome         bool
+	iconMode           IconMode
+	iconWidths         [IconNone]int
+	tabRenderer        TabRenderer
+	infoColor          lipgloss.TerminalColor
+	warningColor       lipgloss.TerminalColor
+	errorColor         lipgloss.TerminalColor
+	clearBadges        bool
+	groupColors        map[string]lipgloss.TerminalColor
+	position           lipgloss.Position
+	tabOverflow        TabOverflow
+	sidebar            bool
+	sidebarWidth       int
+	sidebarCollapsed   bool
+	appTitle           string
+	appTitleStyle      lipgloss.Style
 	titleStyleActive   lipgloss.Style
 	titleStyleInactive lipgloss.Style
 	titleStyleDisabled lipgloss.Style
+	customColors       headerColors
+	showTabIndex       bool
+	wrapNavigation     bool
+	closeButton        bool
+}
+
+// headerColors is hold the colors the custom styles of the header set, they are kept over the theme colors.
+type headerColors struct {
+	appTitle styleColors
+	active   styleColors
+	inactive styleColors
+	disabled styleColors
 }
 
 // defaultHeaderProperties returns the default properties of the header.
 func defaultHeaderProperties() *headerProperties {
-	borderColor := "39"
-	leftPadding := 2
-	rightPadding := 2
+	theme := DefaultTheme()
+	borderSet := RoundedBorderSet()
+	padding := 2
 	return &headerProperties{
-		borderColor:     borderColor,
-		leftTabPadding:  leftPadding,
-		rightTabPadding: rightPadding,
-		titleStyleActive: func() lipgloss.Style {
-			b := lipgloss.DoubleBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("205"))
-		}(),
-		titleStyleInactive: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("255"))
-		}(),
-		titleStyleDisabled: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("240")).Foreground(lipgloss.Color("240"))
-		}(),
+		borderColor:  theme.Border,
+		borderSet:    borderSet,
+		glyphs:       unicodeGlyphs,
+		iconMode:     defaultIconMode(),
+		iconWidths:   [IconNone]int{1, 1, 1},
+		infoColor:    theme.Accent,
+		warningColor: theme.Warning,
+		errorColor:   theme.Error,
+		clearBadges:  true,
+		groupColors:  make(map[string]lipgloss.TerminalColor),
+		sidebarWidth: defaultSidebarWidth,
+		appTitleStyle: lipgloss.NewStyle().Bold(true).
+			Padding(0, 1).
+			Foreground(theme.Accent),
+		titleStyleActive: lipgloss.NewStyle().BorderStyle(borderSet.ActiveTab).
+			Padding(0, padding).
+			BorderForeground(theme.ActiveTabBorder),
+		titleStyleInactive: lipgloss.NewStyle().BorderStyle(borderSet.Tab).
+			Padding(0, padding).
+			BorderForeground(theme.InactiveTabBorder),
+		titleStyleDisabled: lipgloss.NewStyle().BorderStyle(borderSet.Tab).
+			Padding(0, padding).
+			BorderForeground(theme.DisabledTabBorder).Foreground(theme.DisabledTabText),
 	}
 }
 
 // commonHeader is hold the header required fields.
 type commonHeader struct {
-	key   string
-	title string
+	key    string
+	title  string
+	pinned bool
+	icon   Icon
+	badge  Badge
+
+	busy        bool
+	progress    float64
+	hasProgress bool
+	monitor     pageMonitor
+
+	accent      lipgloss.TerminalColor
+	accentFrame bool
+	group       string
 }
 
 func (h *header) Init() tea.Cmd {
@@ -121,18 +197,13 @@ func (h *header) Update(msg tea.Msg) (*header, tea.Cmd) {
 
 		cmds = append(cmds, h.Listen())
 	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, h.keyMap.SwitchTabLeft):
-			if !h.GetLockTabs() {
-				h.currentTab = max(h.currentTab-1, 0)
-			}
-		case key.Matches(msg, h.keyMap.SwitchTabRight):
-			if !h.GetLockTabs() {
-				h.currentTab = min(h.currentTab+1, len(h.headers)-1)
-			}
-		}
-
 		cmds = append(cmds, h.Listen())
+	case AddNewHeaderWidget:
+		h.addWidget(msg.Key, msg.Value)
+	case UpdateHeaderWidgetContent:
+		h.updateWidgetContent(msg.Key, msg.Value)
+	case DeleteHeaderWidget:
+		h.deleteWidget(msg.Key)
 	}
 
 	return h, tea.Batch(cmds...)
@@ -161,23 +232,375 @@ func (h *header) SendIsTerminalSizeEnough(isEnough bool) {
 }
 
 // calculateTitleLength calculates the length of the title.
+// The terminal size is not enough only if the current tab does not fit, the other tabs scroll.
 func (h *header) calculateTitleLength() {
-	var titleLen int
-	for _, hdr := range h.headers {
-		titleLen += len([]rune(hdr.title))
-		titleLen += h.properties.leftTabPadding + h.properties.rightTabPadding
-		titleLen += 2 // for the border between titles
+	h.SendIsTerminalSizeEnough(h.layoutTabs())
+}
+
+// layoutTabs measures the rendered tabs and calculates the visible ones around the current tab.
+// The application title and the widgets take their width first, the widgets are hidden from the left
+// while the current tab does not fit. It returns the current tab fits into the header or not.
+// The tabs are not measured while they are on the sidebar, the visible tabs of the sidebar are calculated instead. The wrapped tabs are broken into rows,
+// the visible tabs are the ones on the row of the current tab.
+func (h *header) layoutTabs() bool {
+	var widths []int
+	if h.properties.sidebar {
+		h.layoutSidebar()
+	} else {
+		widths = make([]int, len(h.headers))
+		for i := range h.headers {
+			widths[i] = lipgloss.Width(h.renderTab(i))
+		}
 	}
 
-	requiredLineCountForLine := h.viewport.Width - (titleLen + 2)
+	widgetWidths := make([]int, len(h.widgets))
+	for i := range h.widgets {
+		widgetWidths[i] = lipgloss.Width(h.renderWidget(i))
+	}
 
-	if requiredLineCountForLine < 0 {
-		h.SendIsTerminalSizeEnough(false)
-	} else {
-		h.SendIsTerminalSizeEnough(true)
+	appTitleWidth := lipgloss.Width(h.renderAppTitle())
+	available := h.viewport.Width - 2 - appTitleWidth
+	var start, end, reserved int
+	var ok bool
+	for h.widgetOffset = 0; h.widgetOffset <= len(h.widgets); h.widgetOffset++ {
+		reserved = 0
+		for _, width := range widgetWidths[h.widgetOffset:] {
+			reserved += width
+		}
+		if h.wrapping() {
+			start, end, ok = h.wrapTabs(widths, available-reserved)
+		} else {
+			start, end, ok = fitWindow(widths, h.currentTab, h.offset, available-reserved, h.overflowWidth)
+		}
+		if ok {
+			break
+		}
+	}
+	h.widgetOffset = min(h.widgetOffset, len(h.widgets))
+	h.offset, h.visibleEnd = start, end
+
+	h.titleLength = appTitleWidth + reserved
+	if !h.wrapping() {
+		h.titleLength += h.overflowWidth(start, len(widths)-end)
+	}
+	for _, width := range widths[start:end] {
+		h.titleLength += width
+	}
+	return ok
+}
+
+// overflowWidth returns the width of the indicators of the hidden tabs.
+func (h *header) overflowWidth(before, after int) int {
+	var width int
+	if before > 0 {
+		width += lipgloss.Width(h.renderOverflow(before, true))
+	}
+	if after > 0 {
+		width += lipgloss.Width(h.renderOverflow(after, false))
+	}
+	return width
+}
+
+// renderTab renders the tab by the given index with the tab renderer.
+// The first tab of each run of a tab group is rendered with the label of the group, they are measured together.
+func (h *header) renderTab(index int) string {
+	hdr := h.headers[index]
+	tab := placeOnFrame(h.GetTabRenderer().RenderTab(h.displayTitle(index, hdr), h.tabState(index), index))
+	if hdr.group == "" || (index > 0 && h.headers[index-1].group == hdr.group) {
+		return tab
 	}
 
-	h.titleLength = titleLen
+	style := lipgloss.NewStyle().
+		Foreground(h.accentColor(h.properties.groupColors[hdr.group])).
+		Bold(h.properties.monochrome).
+		Renderer(chromeRenderer(h.properties.monochrome))
+	return lipgloss.JoinHorizontal(lipgloss.Top, placeOnFrame(style.Render(" "+hdr.group+" ")), tab)
+}
+
+// renderOverflow renders the indicator of the given count of the hidden tabs before or after the visible ones.
+// It is marked with the most severe badge of the hidden tabs, e.g. "3 more ›●".
+func (h *header) renderOverflow(count int, before bool) string {
+	style := lipgloss.NewStyle().
+		Foreground(h.properties.titleStyleInactive.GetForeground()).
+		Renderer(chromeRenderer(h.properties.monochrome))
+
+	hidden := h.headers[len(h.headers)-count:]
+	if before {
+		hidden = h.headers[:count]
+	}
+
+	var marker string
+	var severest Badge
+	for _, hdr := range hidden {
+		severest.State = max(severest.State, hdr.badge.State)
+	}
+	if severest.State != 0 {
+		marker = h.badgeStyle(severest.State, style).Render(h.properties.glyphs.badge)
+	}
+
+	text := overflowText(count, before, marker, h.properties.glyphs)
+	if marker == "" {
+		return placeOnFrame(style.Render(text))
+	}
+	// the marker is rendered apart, the text after it keeps the color of the indicator
+	head, tail, _ := strings.Cut(text, marker)
+	return placeOnFrame(style.Render(head) + marker + style.Render(tail))
+}
+
+// renderAppTitle renders the application title before the tabs, it is empty if there is no title.
+func (h *header) renderAppTitle() string {
+	if h.properties.appTitle == "" {
+		return ""
+	}
+	return placeOnFrame(h.properties.appTitleStyle.Render(h.properties.appTitle))
+}
+
+// renderWidget renders the widget of the header by the given index with the renderer of the status bar.
+func (h *header) renderWidget(index int) string {
+	return placeOnFrame(h.statusBar.GetStatusBarRenderer().RenderWidget(h.widgets[index].Value, WidgetState{Header: true}, index))
+}
+
+// badgedTitle returns the title with the badge of the tab, the badge is put before the close glyph.
+func (h *header) badgedTitle(title string, state TabState) string {
+	if state.Badge.State == 0 {
+		return title
+	}
+
+	text := state.Badge.Text
+	if text == "" {
+		text = h.properties.glyphs.badge
+	}
+
+	closeGlyph := " " + h.properties.glyphs.close
+	name, closable := strings.CutSuffix(title, closeGlyph)
+
+	// the parts are rendered apart, so the parts after the badge keep the text style of the tab
+	base := textStyle(h.tabStyle(state))
+	badged := base.Render(name) + h.badgeStyle(state.Badge.State, base).Render(" "+text)
+	if closable {
+		badged += base.Render(closeGlyph)
+	}
+	return badged
+}
+
+// badgeStyle returns the given style with the color of the badge state, the warnings and the errors are bold in monochrome mode.
+func (h *header) badgeStyle(state BadgeState, style lipgloss.Style) lipgloss.Style {
+	switch state {
+	case BadgeWarning:
+		style = style.Foreground(h.properties.warningColor)
+	case BadgeError:
+		style = style.Foreground(h.properties.errorColor)
+	default:
+		style = style.Foreground(h.properties.infoColor)
+	}
+	if h.properties.monochrome && state >= BadgeWarning {
+		style = style.Bold(true)
+	}
+	return style
+}
+
+// displayTitle returns the title of the header as it is rendered on the tab.
+func (h *header) displayTitle(index int, hdr commonHeader) string {
+	title := hdr.title
+	if hdr.hasProgress {
+		title += fmt.Sprintf(" %d%%", int(hdr.progress*100))
+	}
+	switch hdr.monitor.mark {
+	case markActivity:
+		title += " " + h.properties.glyphs.activity
+	case markSilence:
+		title += " " + h.properties.glyphs.silence
+	}
+	if hdr.busy {
+		title = h.spinner() + " " + title // the spinner replaces the icon
+	} else if icon := h.iconGlyph(hdr); icon != "" {
+		title = icon + " " + title
+	}
+	if h.properties.showTabIndex {
+		title = fmt.Sprintf("%d %s", index+1, title)
+	}
+	if h.hasCloseButton(hdr) {
+		title += " " + h.properties.glyphs.close
+	}
+	return title
+}
+
+// hasCloseButton returns the close glyph is rendered on the tab or not.
+func (h *header) hasCloseButton(hdr commonHeader) bool {
+	return h.properties.closeButton && !h.lockTabs && !hdr.pinned && len(h.headers) > 1
+}
+
+// TabAt returns the index of the tab at the given position of the header, the position is relative to the header.
+// onClose is true if the position is on the close glyph of the tab.
+// The indicators of the hidden tabs return the nearest hidden tab.
+func (h *header) TabAt(x, y int) (index int, onClose bool, ok bool) {
+	if h.wrapping() && (y < h.frameRowTop() || y >= h.frameRowTop()+headerHeight) {
+		return h.wrappedTabAt(x, y)
+	}
+
+	y -= h.frameRowTop()
+	if h.properties.sidebar || y < 0 || y > 2 || h.viewport.Width-(h.titleLength+2) < 0 {
+		return 0, false, false
+	}
+
+	start := 1 + lipgloss.Width(h.renderAppTitle()) // for the left corner and the application title
+	if h.offset > 0 && !h.wrapping() {
+		width := lipgloss.Width(h.renderOverflow(h.offset, true))
+		if x >= start && x < start+width {
+			return h.offset - 1, false, true
+		}
+		start += width
+	}
+	if index, onClose, ok := h.tabInRange(x, start, h.offset, h.visibleEnd); ok {
+		return index, onClose, true
+	}
+	for i := h.offset; i < h.visibleEnd; i++ {
+		start += lipgloss.Width(h.renderTab(i))
+	}
+	if h.visibleEnd < len(h.headers) && !h.wrapping() {
+		width := lipgloss.Width(h.renderOverflow(len(h.headers)-h.visibleEnd, false))
+		if x >= start && x < start+width {
+			return h.visibleEnd, false, true
+		}
+	}
+
+	return 0, false, false
+}
+
+// WidgetAt returns the key of the widget at the given position of the header, the position is relative to the header.
+func (h *header) WidgetAt(x, y int) (string, bool) {
+	y -= h.frameRowTop()
+	if y < 0 || y > 2 || h.viewport.Width-(h.titleLength+2) < 0 {
+		return "", false
+	}
+
+	end := h.viewport.Width - 1 // for the right corner
+	for i := len(h.widgets) - 1; i >= h.widgetOffset; i-- {
+		width := lipgloss.Width(h.renderWidget(i))
+		if x >= end-width && x < end {
+			return h.widgets[i].Key, true
+		}
+		end -= width
+	}
+
+	return "", false
+}
+
+// tabInRange returns the index of the tab at the given column among the tabs of the range [first, end) rendered from the start column.
+func (h *header) tabInRange(x, start, first, end int) (index int, onClose bool, ok bool) {
+	for i := first; i < end; i++ {
+		rendered := h.renderTab(i)
+		width := lipgloss.Width(rendered)
+		if x >= start && x < start+width {
+			onClose := h.hasCloseButton(h.headers[i]) && x-start == glyphColumn(rendered, h.properties.glyphs.close)
+			return i, onClose, true
+		}
+		start += width
+	}
+	return 0, false, false
+}
+
+// glyphColumn returns the column of the last occurrence of the glyph on the rendered text, it is -1 if there is none.
+func glyphColumn(rendered, glyph string) int {
+	for _, line := range strings.Split(ansi.Strip(rendered), "\n") {
+		if index := strings.LastIndex(line, glyph); index >= 0 {
+			return lipgloss.Width(line[:index])
+		}
+	}
+	return -1
+}
+
+// tabState returns the state of the tab by the given index.
+func (h *header) tabState(index int) TabState {
+	active := index == h.currentTab
+	hdr := h.headers[index]
+	accent := hdr.accent
+	if accent == nil {
+		accent = h.properties.groupColors[hdr.group]
+	}
+	return TabState{
+		Active:   active,
+		Focused:  active && h.focused,
+		Disabled: !active && h.GetLockTabs(),
+		Badge:    hdr.badge,
+		Accent:   h.accentColor(accent),
+		Group:    hdr.group,
+		Floating: h.isFloating(index),
+	}
+}
+
+// accentColor returns the given accent color, it is nil in monochrome mode.
+func (h *header) accentColor(color lipgloss.TerminalColor) lipgloss.TerminalColor {
+	if h.properties.monochrome {
+		return nil
+	}
+	return color
+}
+
+// frameAccent returns the accent (or the group) color of the active tab if it tints the frame, it is nil otherwise.
+func (h *header) frameAccent() lipgloss.TerminalColor {
+	if h.currentTab < 0 || h.currentTab >= len(h.headers) || !h.headers[h.currentTab].accentFrame {
+		return nil
+	}
+	return h.tabState(h.currentTab).Accent
+}
+
+// frameColor returns the color of the frame line and the corners.
+func (h *header) frameColor() lipgloss.TerminalColor {
+	if accent := h.frameAccent(); accent != nil {
+		return accent
+	}
+	return h.properties.borderColor
+}
+
+// tabStyle returns the style of the tab by the given state, the accent color tints the borders of the enabled tabs.
+// The active tab is bold and the disabled tabs are faint in monochrome mode.
+// The floating tabs have no joints on their sides.
+func (h *header) tabStyle(state TabState) lipgloss.Style {
+	style := h.stateTabStyle(state)
+	if state.Floating {
+		style = style.BorderStyle(floatingBorder(style.GetBorderStyle()))
+	}
+	return style
+}
+
+// stateTabStyle returns the style of the tab by the given state without the floating sides.
+func (h *header) stateTabStyle(state TabState) lipgloss.Style {
+	switch {
+	case state.Active:
+		style := h.properties.titleStyleActive
+		if state.Accent != nil {
+			style = style.BorderForeground(state.Accent)
+		}
+		if h.properties.monochrome {
+			style = style.Bold(true)
+		}
+		if state.Focused {
+			style = style.Reverse(true)
+		}
+		return style
+	case state.Disabled:
+		if h.properties.monochrome {
+			return h.properties.titleStyleDisabled.Faint(true)
+		}
+		return h.properties.titleStyleDisabled
+	case state.Accent != nil:
+		return h.properties.titleStyleInactive.BorderForeground(state.Accent)
+	default:
+		return h.properties.titleStyleInactive
+	}
+}
+
+// Height returns the height of the header, it is a single frame line if the tabs are on the sidebar and there is nothing else to show.
+// The wrapped tabs take a bar height for each row.
+func (h *header) Height() int {
+	switch {
+	case h.properties.sidebar && h.properties.appTitle == "" && len(h.widgets) == 0:
+		return 1
+	case h.wrapping():
+		return headerHeight * max(len(h.rows), 1)
+	}
+	return headerHeight
 }
 
 // View renders the header.
@@ -192,34 +615,64 @@ func (h *header) View() string {
 		return ""
 	}
 
-	line := strings.Repeat("─", requiredLineCount)
-	line = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(line)
+	frame := h.properties.borderSet.Frame
+	bottom := h.properties.position == lipgloss.Bottom
+	lineGlyph := frame.Top
+	if bottom {
+		lineGlyph = frame.Bottom
+	}
+	line := strings.Repeat(lineGlyph, requiredLineCount)
+	line = lipgloss.NewStyle().Foreground(h.frameColor()).Render(line)
+
+	if h.Height() == 1 {
+		// the tabs are on the sidebar and there is nothing else to show, only the frame line is left
+		left, right := frame.TopLeft, frame.TopRight
+		if bottom {
+			left, right = frame.BottomLeft, frame.BottomRight
+		}
+		return lipgloss.NewStyle().Foreground(h.frameColor()).Render(left) + line +
+			lipgloss.NewStyle().Foreground(h.frameColor()).Render(right)
+	}
 
 	var renderedTitles []string
-	renderedTitles = append(renderedTitles, "")
-	for i, hdr := range h.headers {
-		if i == h.currentTab {
-			renderedTitles = append(renderedTitles, h.properties.titleStyleActive.Render(hdr.title))
-		} else {
-			if h.GetLockTabs() {
-				renderedTitles = append(renderedTitles, h.properties.titleStyleDisabled.Render(hdr.title))
-			} else {
-				renderedTitles = append(renderedTitles, h.properties.titleStyleInactive.Render(hdr.title))
-			}
+	renderedTitles = append(renderedTitles, h.renderAppTitle())
+	if !h.properties.sidebar {
+		if h.offset > 0 && !h.wrapping() {
+			renderedTitles = append(renderedTitles, h.renderOverflow(h.offset, true))
+		}
+		for i := h.offset; i < h.visibleEnd; i++ {
+			renderedTitles = append(renderedTitles, h.renderTab(i))
 		}
+		if h.visibleEnd < len(h.headers) && !h.wrapping() {
+			renderedTitles = append(renderedTitles, h.renderOverflow(len(h.headers)-h.visibleEnd, false))
+		}
+	}
+	renderedTitles = append(renderedTitles, line)
+	for i := h.widgetOffset; i < len(h.widgets); i++ {
+		renderedTitles = append(renderedTitles, h.renderWidget(i))
 	}
 
-	leftCorner := lipgloss.JoinVertical(lipgloss.Top, "╭", "│")
-	rightCorner := lipgloss.JoinVertical(lipgloss.Top, "╮", "│")
-	leftCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(leftCorner)
-	rightCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(rightCorner)
+	// the corners join the frame line to the sides of the frame, below the line on the top and above it on the bottom
+	leftCorner := lipgloss.JoinVertical(lipgloss.Top, frame.TopLeft, frame.Left)
+	rightCorner := lipgloss.JoinVertical(lipgloss.Top, frame.TopRight, frame.Right)
+	position := lipgloss.Bottom
+	if bottom {
+		leftCorner = lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.BottomLeft)
+		rightCorner = lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.BottomRight)
+		position = lipgloss.Top
+	}
+	leftCorner = lipgloss.NewStyle().Foreground(h.frameColor()).Render(leftCorner)
+	rightCorner = lipgloss.NewStyle().Foreground(h.frameColor()).Render(rightCorner)
 
-	return lipgloss.JoinHorizontal(lipgloss.Bottom, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, append(renderedTitles, line)...), rightCorner)
+	frameRow := lipgloss.JoinHorizontal(position, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, renderedTitles...), rightCorner)
+	if !h.wrapping() {
+		return frameRow
+	}
+	return h.wrappedView(frameRow)
 }
 
 // SetLeftPadding sets the left padding of the header.
 func (h *header) SetLeftPadding(padding int) {
-	h.properties.leftTabPadding = padding
 	h.properties.titleStyleActive = h.properties.titleStyleActive.PaddingLeft(padding)
 	h.properties.titleStyleInactive = h.properties.titleStyleInactive.PaddingLeft(padding)
 	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.PaddingLeft(padding)
@@ -229,7 +682,6 @@ func (h *header) SetLeftPadding(padding int) {
 
 // SetRightPadding sets the right padding of the header.
 func (h *header) SetRightPadding(padding int) {
-	h.properties.rightTabPadding = padding
 	h.properties.titleStyleActive = h.properties.titleStyleActive.PaddingRight(padding)
 	h.properties.titleStyleInactive = h.properties.titleStyleInactive.PaddingRight(padding)
 	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.PaddingRight(padding)
@@ -237,39 +689,341 @@ func (h *header) SetRightPadding(padding int) {
 	h.calculateTitleLength()
 }
 
-// SetInactiveTabTextColor sets the idle tab color of the header.
+// SetCloseButton sets the close glyph is rendered on the closable tabs or not.
+func (h *header) SetCloseButton(show bool) {
+	h.properties.closeButton = show
+	h.calculateTitleLength()
+}
+
+// GetCloseButton returns the close glyph is rendered on the closable tabs or not.
+func (h *header) GetCloseButton() bool {
+	return h.properties.closeButton
+}
+
+// SetShowTabIndex sets the tab index prefix is rendered on the titles or not.
+func (h *header) SetShowTabIndex(show bool) {
+	h.properties.showTabIndex = show
+	h.calculateTitleLength()
+}
+
+// GetShowTabIndex returns the tab index prefix is rendered on the titles or not.
+func (h *header) GetShowTabIndex() bool {
+	return h.properties.showTabIndex
+}
+
+// SetInactiveTabTextColor sets the idle tab color of the header, it replaces the color of a custom style.
 func (h *header) SetInactiveTabTextColor(color string) {
-	h.properties.titleStyleInactive = h.properties.titleStyleInactive.Foreground(lipgloss.Color(color))
+	h.properties.customColors.inactive.text = nil
+	if !h.properties.monochrome {
+		h.properties.titleStyleInactive = h.properties.titleStyleInactive.Foreground(lipgloss.Color(color))
+	}
 }
 
-// SetInactiveTabBorderColor sets the idle tab border color of the header.
+// SetInactiveTabBorderColor sets the idle tab border color of the header, it replaces the color of a custom style.
 func (h *header) SetInactiveTabBorderColor(color string) {
-	h.properties.titleStyleInactive = h.properties.titleStyleInactive.BorderForeground(lipgloss.Color(color))
+	h.properties.customColors.inactive.border = nil
+	if !h.properties.monochrome {
+		h.properties.titleStyleInactive = h.properties.titleStyleInactive.BorderForeground(lipgloss.Color(color))
+	}
 }
 
-// SetActiveTabTextColor sets the active tab color of the header.
+// SetActiveTabTextColor sets the active tab color of the header, it replaces the color of a custom style.
 func (h *header) SetActiveTabTextColor(color string) {
-	h.properties.titleStyleActive = h.properties.titleStyleActive.Foreground(lipgloss.Color(color))
+	h.properties.customColors.active.text = nil
+	if !h.properties.monochrome {
+		h.properties.titleStyleActive = h.properties.titleStyleActive.Foreground(lipgloss.Color(color))
+	}
 }
 
-// SetActiveTabBorderColor sets the active tab border color of the header.
+// SetActiveTabBorderColor sets the active tab border color of the header, it replaces the color of a custom style.
 func (h *header) SetActiveTabBorderColor(color string) {
-	h.properties.titleStyleActive = h.properties.titleStyleActive.BorderForeground(lipgloss.Color(color))
+	h.properties.customColors.active.border = nil
+	if !h.properties.monochrome {
+		h.properties.titleStyleActive = h.properties.titleStyleActive.BorderForeground(lipgloss.Color(color))
+	}
 }
 
 // SetBorderColor sets the border color of the header.
 func (h *header) SetBorderColor(color string) {
-	h.properties.borderColor = color
+	if !h.properties.monochrome {
+		h.properties.borderColor = lipgloss.Color(color)
+	}
+}
+
+// applyTheme sets the border color and the tab colors of the header by the given theme.
+// The colors of the custom styles are kept, they are dropped in monochrome mode only.
+func (h *header) applyTheme(theme Theme) {
+	h.properties.borderColor = theme.Border
+	h.properties.infoColor = theme.Accent
+	h.properties.warningColor = theme.Warning
+	h.properties.errorColor = theme.Error
+	h.properties.appTitleStyle = h.themeStyle(h.properties.appTitleStyle,
+		h.properties.customColors.appTitle, styleColors{text: theme.Accent})
+	h.properties.titleStyleActive = h.themeStyle(h.properties.titleStyleActive,
+		h.properties.customColors.active, styleColors{theme.ActiveTabText, theme.ActiveTabBorder})
+	h.properties.titleStyleInactive = h.themeStyle(h.properties.titleStyleInactive,
+		h.properties.customColors.inactive, styleColors{theme.InactiveTabText, theme.InactiveTabBorder})
+	h.properties.titleStyleDisabled = h.themeStyle(h.properties.titleStyleDisabled,
+		h.properties.customColors.disabled, styleColors{theme.DisabledTabText, theme.DisabledTabBorder})
+}
+
+// themeStyle returns the style with the custom colors, and with the theme colors where the custom ones are not set.
+func (h *header) themeStyle(style lipgloss.Style, custom, theme styleColors) lipgloss.Style {
+	if h.properties.monochrome {
+		custom = styleColors{}
+	}
+	return paintStyle(style, custom.or(theme))
+}
+
+// SetWrapNavigation sets the navigation cycles from the last tab to the first one (and vice versa) or not.
+func (h *header) SetWrapNavigation(wrap bool) {
+	h.properties.wrapNavigation = wrap
+}
+
+// GetWrapNavigation returns the navigation cycles from the last tab to the first one or not.
+func (h *header) GetWrapNavigation() bool {
+	return h.properties.wrapNavigation
+}
+
+// MoveTab moves the current tab by the given step, negative step moves to the left.
+// It respects the locked tabs and the wrap navigation, and returns true if the current tab is changed.
+func (h *header) MoveTab(step int) bool {
+	count := len(h.headers)
+	if h.GetLockTabs() || count == 0 {
+		return false
+	}
+
+	next := h.currentTab + step
+	if h.properties.wrapNavigation {
+		next = ((next % count) + count) % count
+	} else {
+		next = max(min(next, count-1), 0)
+	}
+
+	return h.SelectTab(next)
+}
+
+// SelectTab sets the current tab index if the tabs are not locked.
+// It returns true if the current tab is changed.
+func (h *header) SelectTab(index int) bool {
+	if h.GetLockTabs() || index < 0 || index >= len(h.headers) || index == h.currentTab {
+		return false
+	}
+
+	h.currentTab = index
+	h.clearActiveMarks()
+	h.layoutTabs() // the active and the inactive tabs may have different widths
+	return true
 }
 
 // SetCurrentTab sets the current tab index.
 func (h *header) SetCurrentTab(tab int) {
 	h.currentTab = tab
+	h.clearActiveMarks()
+	h.layoutTabs()
+}
+
+// SetFocused sets the header (tab bar) has the focus or not.
+func (h *header) SetFocused(focused bool) {
+	h.focused = focused
+	h.layoutTabs()
+}
+
+// setBorderSet sets the frame and the tab borders by the given border set, the close button is drawn with the given glyphs.
+func (h *header) setBorderSet(set BorderSet, g glyphs) {
+	h.properties.borderSet = set
+	h.properties.glyphs = g
+	h.properties.titleStyleActive = h.properties.titleStyleActive.BorderStyle(set.ActiveTab)
+	h.properties.titleStyleInactive = h.properties.titleStyleInactive.BorderStyle(set.Tab)
+	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.BorderStyle(set.Tab)
+	h.calculateTitleLength()
+}
+
+// setMonochrome sets the tabs are distinguished by the text attributes instead of the colors or not.
+func (h *header) setMonochrome(monochrome bool) {
+	renderer := chromeRenderer(monochrome)
+	h.properties.monochrome = monochrome
+	h.properties.appTitleStyle = h.properties.appTitleStyle.Renderer(renderer)
+	h.properties.titleStyleActive = h.properties.titleStyleActive.Renderer(renderer)
+	h.properties.titleStyleInactive = h.properties.titleStyleInactive.Renderer(renderer)
+	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.Renderer(renderer)
+}
+
+// SetActiveTabStyle sets the style of the active tab, the border and the colors the style does not set are kept.
+func (h *header) SetActiveTabStyle(style lipgloss.Style) {
+	h.properties.customColors.active = colorsOf(style).or(h.properties.customColors.active)
+	h.properties.titleStyleActive = h.chromeStyle(style, h.properties.titleStyleActive, h.properties.borderSet.ActiveTab)
+	h.calculateTitleLength()
+}
+
+// GetActiveTabStyle returns the style of the active tab.
+func (h *header) GetActiveTabStyle() lipgloss.Style {
+	return h.properties.titleStyleActive
+}
+
+// SetInactiveTabStyle sets the style of the inactive tabs, the border and the colors the style does not set are kept.
+func (h *header) SetInactiveTabStyle(style lipgloss.Style) {
+	h.properties.customColors.inactive = colorsOf(style).or(h.properties.customColors.inactive)
+	h.properties.titleStyleInactive = h.chromeStyle(style, h.properties.titleStyleInactive, h.properties.borderSet.Tab)
+	h.calculateTitleLength()
+}
+
+// GetInactiveTabStyle returns the style of the inactive tabs.
+func (h *header) GetInactiveTabStyle() lipgloss.Style {
+	return h.properties.titleStyleInactive
+}
+
+// SetDisabledTabStyle sets the style of the tabs while the tabs are locked, the border and the colors the style does not set are kept.
+func (h *header) SetDisabledTabStyle(style lipgloss.Style) {
+	h.properties.customColors.disabled = colorsOf(style).or(h.properties.customColors.disabled)
+	h.properties.titleStyleDisabled = h.chromeStyle(style, h.properties.titleStyleDisabled, h.properties.borderSet.Tab)
+	h.calculateTitleLength()
+}
+
+// GetDisabledTabStyle returns the style of the tabs while the tabs are locked.
+func (h *header) GetDisabledTabStyle() lipgloss.Style {
+	return h.properties.titleStyleDisabled
+}
+
+// chromeStyle returns the given style rendered by the renderer of the current color mode.
+// The border and the colors the style does not set are taken from the border set and the previous style,
+// the style has no colors in monochrome mode.
+func (h *header) chromeStyle(style, previous lipgloss.Style, border lipgloss.Border) lipgloss.Style {
+	style = inheritStyle(style, previous, border)
+	if h.properties.monochrome {
+		style = paintStyle(style, styleColors{})
+	}
+	return style.Renderer(chromeRenderer(h.properties.monochrome))
+}
+
+// SetPosition sets the header is rendered on the top (lipgloss.Top) or on the bottom (lipgloss.Bottom) of the frame.
+func (h *header) SetPosition(position lipgloss.Position) {
+	h.properties.position = position
+}
+
+// GetPosition returns the header is rendered on the top or on the bottom of the frame.
+func (h *header) GetPosition() lipgloss.Position {
+	return h.properties.position
+}
+
+// SetAppTitle sets the application title rendered before the tabs, empty title removes it.
+func (h *header) SetAppTitle(title string) {
+	h.properties.appTitle = title
+	h.calculateTitleLength()
+}
+
+// GetAppTitle returns the application title.
+func (h *header) GetAppTitle() string {
+	return h.properties.appTitle
+}
+
+// SetAppTitleStyle sets the style of the application title, the colors the style does not set are kept.
+func (h *header) SetAppTitleStyle(style lipgloss.Style) {
+	h.properties.customColors.appTitle = colorsOf(style).or(h.properties.customColors.appTitle)
+	if _, ok := style.GetForeground().(lipgloss.NoColor); ok {
+		style = style.Foreground(h.properties.appTitleStyle.GetForeground())
+	}
+	if h.properties.monochrome {
+		style = paintStyle(style, styleColors{})
+	}
+	h.properties.appTitleStyle = style.Renderer(chromeRenderer(h.properties.monochrome))
+	h.calculateTitleLength()
+}
+
+// GetAppTitleStyle returns the style of the application title.
+func (h *header) GetAppTitleStyle() lipgloss.Style {
+	return h.properties.appTitleStyle
+}
+
+// GetWidget returns the widget of the header by the given key.
+func (h *header) GetWidget(key string) *commonWidget {
+	for _, widget := range h.widgets {
+		if widget.Key == key {
+			return widget
+		}
+	}
+
+	return nil
+}
+
+func (h *header) addWidget(key, value string) {
+	// skip if key already exists
+	if h.GetWidget(key) != nil {
+		return
+	}
+
+	h.widgets = append(h.widgets, &commonWidget{
+		Key:   key,
+		Value: value,
+	})
+
+	h.calculateTitleLength()
+}
+
+// updateWidgetContent updates the value of the widget by the given key, it adds the widget if it doesn't exist.
+func (h *header) updateWidgetContent(key, value string) {
+	x := h.GetWidget(key)
+	if x == nil {
+		h.addWidget(key, value)
+		return
+	}
+	x.Value = value
+
+	h.calculateTitleLength()
+}
+
+// SetClickHandler sets the callback of the widget of the header by the given key, nil removes it.
+func (h *header) SetClickHandler(key string, handler func() tea.Cmd) {
+	if handler == nil {
+		delete(h.clickHandlers, key)
+		return
+	}
+	h.clickHandlers[key] = handler
+}
+
+// Click calls the callback of the widget of the header by the given key, it returns nil if there is no callback.
+func (h *header) Click(key string) tea.Cmd {
+	if handler, ok := h.clickHandlers[key]; ok {
+		return handler()
+	}
+	return nil
+}
+
+func (h *header) deleteWidget(key string) {
+	for i, widget := range h.widgets {
+		if widget.Key == key {
+			h.widgets = append(h.widgets[:i], h.widgets[i+1:]...)
+			break
+		}
+	}
+
+	h.calculateTitleLength()
+}
+
+// DeleteAllWidgets deletes all the widgets of the header.
+func (h *header) DeleteAllWidgets() {
+	h.widgets = nil
+	h.calculateTitleLength()
+}
+
+// SetTabRenderer sets the renderer of the tabs, nil restores the default one.
+func (h *header) SetTabRenderer(renderer TabRenderer) {
+	h.properties.tabRenderer = renderer
+	h.calculateTitleLength()
+}
+
+// GetTabRenderer returns the renderer of the tabs, it is the default one if no renderer is set.
+func (h *header) GetTabRenderer() TabRenderer {
+	if h.properties.tabRenderer == nil {
+		return defaultTabRenderer{header: h}
+	}
+	return h.properties.tabRenderer
 }
 
 // SetLockTabs sets the lock tabs status.
 func (h *header) SetLockTabs(lock bool) {
 	h.lockTabs = lock
+	h.layoutTabs()
 }
 
 // GetLockTabs returns the lock tabs status.
@@ -283,14 +1037,234 @@ func (h *header) GetCurrentTab() int {
 }
 
 // AddCommonHeader adds a new header to the header.
-func (h *header) AddCommonHeader(key string, title string) {
-	h.headers = append(h.headers, commonHeader{
+func (h *header) AddCommonHeader(key string, title string, options ...PageOption) {
+	hdr := commonHeader{
 		key:   key,
 		title: title,
-	})
+	}
+	for _, option := range options {
+		option(&hdr)
+	}
+	h.headers = append(h.headers, hdr)
 	h.calculateTitleLength()
 }
 
+// SetIcon sets the icon of the header by the given key.
+func (h *header) SetIcon(key string, icon Icon) {
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].icon = icon
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// SetAccent sets the accent color of the header by the given key.
+func (h *header) SetAccent(key string, color lipgloss.TerminalColor) {
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].accent = color
+		}
+	}
+}
+
+// SetGroupColor sets the color of the named tab group.
+func (h *header) SetGroupColor(name string, color lipgloss.TerminalColor) {
+	h.properties.groupColors[name] = color
+}
+
+// GetGroupColor returns the color of the named tab group.
+func (h *header) GetGroupColor(name string) lipgloss.TerminalColor {
+	return h.properties.groupColors[name]
+}
+
+// NextGroupTab returns the index of the first tab of the group after the group of the current tab, the groups cycle.
+// Tabs without a group are skipped, ok is false if there is no other group.
+func (h *header) NextGroupTab() (index int, ok bool) {
+	count := len(h.headers)
+	if h.currentTab < 0 || h.currentTab >= count {
+		return 0, false
+	}
+
+	current := h.headers[h.currentTab].group
+	for step := 1; step < count; step++ {
+		i := (h.currentTab + step) % count
+		group := h.headers[i].group
+		if group == "" || group == current {
+			continue
+		}
+		for first := range h.headers {
+			if h.headers[first].group == group {
+				return first, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// SetBusy sets the header by the given key is busy or not.
+func (h *header) SetBusy(key string, busy bool) {
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].busy = busy
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// IsBusy returns the header by the given key is busy or not.
+func (h *header) IsBusy(key string) bool {
+	for _, header := range h.headers {
+		if header.key == key {
+			return header.busy
+		}
+	}
+	return false
+}
+
+// HasBusy returns any of the headers is busy or not.
+func (h *header) HasBusy() bool {
+	for _, header := range h.headers {
+		if header.busy {
+			return true
+		}
+	}
+	return false
+}
+
+// SetProgress sets the progress of the header by the given key, it is clamped to 1 and negative progress removes it, NaN is ignored.
+func (h *header) SetProgress(key string, progress float64) {
+	if math.IsNaN(progress) {
+		return
+	}
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].progress = min(progress, 1)
+			h.headers[i].hasProgress = progress >= 0
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// GetProgress returns the progress of the header by the given key, ok is false if the header has no progress.
+func (h *header) GetProgress(key string) (progress float64, ok bool) {
+	for _, header := range h.headers {
+		if header.key == key {
+			return header.progress, header.hasProgress
+		}
+	}
+	return 0, false
+}
+
+// AdvanceSpinner moves the spinners of the busy headers to the next frame.
+func (h *header) AdvanceSpinner() {
+	h.spinnerFrame++
+}
+
+// spinner returns the current frame of the spinners.
+func (h *header) spinner() string {
+	frames := []rune(h.properties.glyphs.spinner)
+	return string(frames[h.spinnerFrame%len(frames)])
+}
+
+// SetBadge sets the badge of the header by the given key, the zero Badge removes it.
+func (h *header) SetBadge(key string, badge Badge) {
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].badge = badge
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// GetBadge returns the badge of the header by the given key.
+func (h *header) GetBadge(key string) Badge {
+	for _, header := range h.headers {
+		if header.key == key {
+			return header.badge
+		}
+	}
+	return Badge{}
+}
+
+// SetClearBadges sets the badge of a tab is removed when the tab is activated or not.
+func (h *header) SetClearBadges(clear bool) {
+	h.properties.clearBadges = clear
+}
+
+// GetClearBadges returns the badge of a tab is removed when the tab is activated or not.
+func (h *header) GetClearBadges() bool {
+	return h.properties.clearBadges
+}
+
+// clearActiveMarks removes the monitoring mark of the current tab, and its badge if the badges are cleared on activation.
+func (h *header) clearActiveMarks() {
+	if h.currentTab < 0 || h.currentTab >= len(h.headers) {
+		return
+	}
+
+	h.headers[h.currentTab].monitor.mark = markNone
+	if h.properties.clearBadges {
+		h.headers[h.currentTab].badge = Badge{}
+	}
+}
+
+// SetMonitor changes the monitoring settings of the header by the given key, the mark is removed if the monitoring is disabled.
+func (h *header) SetMonitor(key string, change func(*pageMonitor)) {
+	for i, header := range h.headers {
+		if header.key == key {
+			change(&h.headers[i].monitor)
+			if !h.headers[i].monitor.activity && h.headers[i].monitor.silence == 0 {
+				h.headers[i].monitor.mark = markNone
+			}
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// HasMonitor returns any of the headers is monitored or not.
+func (h *header) HasMonitor() bool {
+	for _, header := range h.headers {
+		if header.monitor.activity || header.monitor.silence > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SetIconMode sets the kind of the glyphs the icons are drawn with.
+func (h *header) SetIconMode(mode IconMode) {
+	h.properties.iconMode = mode
+	h.calculateTitleLength()
+}
+
+// SetIconWidth sets the cells the glyphs of the given icon mode are drawn on.
+func (h *header) SetIconWidth(mode IconMode, width int) {
+	if mode < IconNerdFont || mode >= IconNone {
+		return
+	}
+	h.properties.iconWidths[mode] = max(width, 1)
+	h.calculateTitleLength()
+}
+
+// GetIconWidth returns the cells the glyphs of the given icon mode are drawn on, it is 0 for IconNone.
+func (h *header) GetIconWidth(mode IconMode) int {
+	if mode < IconNerdFont || mode >= IconNone {
+		return 0
+	}
+	return h.properties.iconWidths[mode]
+}
+
+// iconGlyph returns the glyph of the icon of the header, it is followed by the spaces of the cells it is drawn on
+// beyond its measured width. It is empty if the icon has no glyph for the icon mode.
+func (h *header) iconGlyph(hdr commonHeader) string {
+	glyph := hdr.icon.glyph(h.properties.iconMode)
+	if glyph == "" {
+		return ""
+	}
+	return glyph + strings.Repeat(" ", max(h.GetIconWidth(h.properties.iconMode)-lipgloss.Width(glyph), 0))
+}
+
 // UpdateCommonHeader updates the header by the given key.
 func (h *header) UpdateCommonHeader(key string, title string) {
 	for i, header := range h.headers {
@@ -301,6 +1275,26 @@ func (h *header) UpdateCommonHeader(key string, title string) {
 	h.calculateTitleLength()
 }
 
+// SetPinned sets the header by the given key is pinned or not. Pinned headers can not be closed by the user.
+func (h *header) SetPinned(key string, pinned bool) {
+	for i, header := range h.headers {
+		if header.key == key {
+			h.headers[i].pinned = pinned
+		}
+	}
+	h.calculateTitleLength()
+}
+
+// IsPinned returns the header by the given key is pinned or not.
+func (h *header) IsPinned(key string) bool {
+	for _, header := range h.headers {
+		if header.key == key {
+			return header.pinned
+		}
+	}
+	return false
+}
+
 // DeleteCommonHeader deletes the header by the given key.
 func (h *header) DeleteCommonHeader(key string) {
 	for i, header := range h.headers {
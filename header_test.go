@@ -0,0 +1,82 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+func TestMoveTab(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrap    bool
+		current int
+		step    int
+		want    int
+		moved   bool
+	}{
+		{"next", false, 0, 1, 1, true},
+		{"previous", false, 1, -1, 0, true},
+		{"stops at the last tab", false, 2, 1, 2, false},
+		{"stops at the first tab", false, 0, -1, 0, false},
+		{"wraps to the first tab", true, 2, 1, 0, true},
+		{"wraps to the last tab", true, 0, -1, 2, true},
+		{"wraps by a long step", true, 1, -5, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHeader()
+			for _, key := range []string{"first", "second", "third"} {
+				h.AddCommonHeader(key, key)
+			}
+			h.SetWrapNavigation(tt.wrap)
+			h.SetCurrentTab(tt.current)
+
+			if moved := h.MoveTab(tt.step); moved != tt.moved || h.GetCurrentTab() != tt.want {
+				t.Errorf("MoveTab(%d) = %v, current tab %d, want %v, %d", tt.step, moved, h.GetCurrentTab(), tt.moved, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectTab(t *testing.T) {
+	h := newHeader()
+	for _, key := range []string{"first", "second"} {
+		h.AddCommonHeader(key, key)
+	}
+
+	for _, index := range []int{-1, 2, 0} {
+		if h.SelectTab(index) {
+			t.Errorf("SelectTab(%d) = true, want out of range and current tabs ignored", index)
+		}
+	}
+	h.SetLockTabs(true)
+	if h.SelectTab(1) || h.MoveTab(1) {
+		t.Error("the tab is changed while the tabs are locked")
+	}
+	h.SetLockTabs(false)
+	if !h.SelectTab(1) || h.GetCurrentTab() != 1 {
+		t.Errorf("current tab = %d, want the selected tab", h.GetCurrentTab())
+	}
+}
+
+func TestTabNavigationKeepsTheActivePageInSync(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second", "third")
+	s.SetTabNavigationWrap(true)
+
+	for _, step := range []struct {
+		msg  tea.KeyMsg
+		want string
+	}{
+		{tea.KeyMsg{Type: tea.KeyCtrlLeft}, "third"},
+		{tea.KeyMsg{Type: tea.KeyCtrlRight}, "first"},
+		{tea.KeyMsg{Type: tea.KeyCtrlRight}, "second"},
+		{tea.KeyMsg{Type: tea.KeyCtrlEnd}, "third"},
+	} {
+		s.Update(step.msg)
+		if s.GetActivePage() != step.want || s.currentTab != s.header.GetCurrentTab() {
+			t.Errorf("%s: active page = %q, current tab %d, header tab %d, want %q in sync",
+				step.msg, s.GetActivePage(), s.currentTab, s.header.GetCurrentTab(), step.want)
+		}
+	}
+}
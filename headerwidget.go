@@ -0,0 +1,83 @@
+package skeleton
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// AddNewHeaderWidget is the message that adds a widget to the right side of the header.
+type AddNewHeaderWidget struct {
+	Key   string
+	Value string
+}
+
+// UpdateHeaderWidgetContent is the message that updates the value of a widget of the header.
+type UpdateHeaderWidgetContent struct {
+	Key   string
+	Value string
+}
+
+// DeleteHeaderWidget is the message that deletes a widget of the header.
+type DeleteHeaderWidget struct {
+	Key string
+}
+
+// SetAppTitle sets the application name or logo rendered on the left of the tab bar, empty title removes it.
+// A logo may be up to three rows high, shorter ones are centered on the frame line.
+func (s *Skeleton) SetAppTitle(title string) *Skeleton {
+	s.header.SetAppTitle(title)
+	s.triggerUpdate()
+	return s
+}
+
+// GetAppTitle returns the application name or logo rendered on the left of the tab bar.
+func (s *Skeleton) GetAppTitle() string {
+	return s.header.GetAppTitle()
+}
+
+// AddHeaderWidget adds a new widget to the right side of the header, e.g. a clock, the environment name or the user.
+// The widgets of the header are rendered like the widgets of the status bar, with the same style and renderer.
+// They are hidden from the left when the current tab does not fit otherwise. OnHeaderWidgetClick sets their callbacks.
+func (s *Skeleton) AddHeaderWidget(key string, value string) *Skeleton {
+	go func() {
+		s.updateChan <- AddNewHeaderWidget{
+			Key:   key,
+			Value: value,
+		}
+	}()
+	return s
+}
+
+// UpdateHeaderWidgetValue updates the value of the widget of the header by the given key.
+// Adds the widget if it doesn't exist.
+func (s *Skeleton) UpdateHeaderWidgetValue(key string, value string) *Skeleton {
+	go func() {
+		s.updateChan <- UpdateHeaderWidgetContent{
+			Key:   key,
+			Value: value,
+		}
+	}()
+
+	return s
+}
+
+// OnHeaderWidgetClick sets the callback of the widget of the header by the given key, it is called when the widget is clicked.
+// The keys of the header widgets are separate from the keys of the status bar, nil handler removes the callback.
+func (s *Skeleton) OnHeaderWidgetClick(key string, handler func() tea.Cmd) *Skeleton {
+	s.header.SetClickHandler(key, handler)
+	return s
+}
+
+// DeleteHeaderWidget deletes the widget of the header by the given key.
+func (s *Skeleton) DeleteHeaderWidget(key string) *Skeleton {
+	go func() {
+		s.updateChan <- DeleteHeaderWidget{
+			Key: key,
+		}
+	}()
+
+	return s
+}
+
+// DeleteAllHeaderWidgets deletes all the widgets of the header.
+func (s *Skeleton) DeleteAllHeaderWidgets() *Skeleton {
+	s.header.DeleteAllWidgets()
+	return s
+}
@@ -0,0 +1,63 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+func TestHeaderWithoutTabs(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout func(h *header)
+	}{
+		{"scroll", func(h *header) {}},
+		{"wrap", func(h *header) { h.SetTabOverflow(TabOverflowWrap) }},
+		{"sidebar", func(h *header) { h.SetSidebar(true) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHeader()
+			tt.layout(h)
+			h.SetAppTitle("an application title wider than the terminal")
+			h.addWidget("clock", "12:00")
+
+			for _, width := range []int{30, 1, 0} {
+				h.Update(tea.WindowSizeMsg{Width: width, Height: 10})
+				_ = h.View()
+			}
+		})
+	}
+}
+
+func TestUpdateHeaderWidgetContent(t *testing.T) {
+	h := newHeader()
+
+	h.Update(UpdateHeaderWidgetContent{Key: "env", Value: "staging"})
+	if w := h.GetWidget("env"); w == nil || w.Value != "staging" {
+		t.Fatalf("widget = %v, want the missing widget added with its value", w)
+	}
+
+	h.Update(UpdateHeaderWidgetContent{Key: "env", Value: "production"})
+	if w := h.GetWidget("env"); w == nil || w.Value != "production" || len(h.widgets) != 1 {
+		t.Fatalf("widgets = %v, want the value of the widget updated", h.widgets)
+	}
+}
+
+func TestHeaderWidgetClick(t *testing.T) {
+	s := NewSkeleton()
+
+	var header, statusBar int
+	s.OnHeaderWidgetClick("clock", func() tea.Cmd { header++; return nil })
+	s.OnWidgetClick("clock", func() tea.Cmd { statusBar++; return nil })
+
+	s.header.Click("clock")
+	if header != 1 || statusBar != 0 {
+		t.Errorf("clicks = %d on the header, %d on the status bar, want the header callback only", header, statusBar)
+	}
+
+	s.OnHeaderWidgetClick("clock", nil)
+	if cmd := s.header.Click("clock"); cmd != nil || header != 1 {
+		t.Error("the removed callback is called")
+	}
+}
@@ -0,0 +1,302 @@
+package skeleton
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+)
+
+// keyHelp is a helper for rendering the key binding help of the Skeleton.
+type keyHelp struct {
+	// active is control the help overlay is shown or not
+	active bool
+
+	// showShortHelp is control the short help row is shown above the widgets or not
+	showShortHelp bool
+
+	// model is hold the help model, it renders the key bindings
+	model help.Model
+
+	// properties are hold the properties of the key help
+	properties *keyHelpProperties
+}
+
+// newKeyHelp returns a new keyHelp.
+func newKeyHelp() *keyHelp {
+	return &keyHelp{
+		model:      help.New(),
+		properties: defaultKeyHelpProperties(),
+	}
+}
+
+// keyHelpProperties are hold the properties of the key help.
+type keyHelpProperties struct {
+	borderColor  lipgloss.TerminalColor
+	border       lipgloss.Border
+	maxWidth     int
+	sectionStyle lipgloss.Style
+}
+
+// defaultKeyHelpProperties returns the default properties of the key help.
+func defaultKeyHelpProperties() *keyHelpProperties {
+	theme := DefaultTheme()
+	return &keyHelpProperties{
+		borderColor:  theme.Border,
+		border:       RoundedBorderSet().Frame,
+		maxWidth:     80,
+		sectionStyle: lipgloss.NewStyle().Foreground(theme.Border).Bold(true),
+	}
+}
+
+// helpSection is hold the titled group of key bindings shown on the help overlay.
+type helpSection struct {
+	title  string
+	groups [][]key.Binding
+}
+
+// SetBorderColor sets the border color and the section title color of the help overlay.
+func (k *keyHelp) SetBorderColor(color string) {
+	k.properties.borderColor = lipgloss.Color(color)
+	k.properties.sectionStyle = k.properties.sectionStyle.Foreground(lipgloss.Color(color))
+}
+
+// applyTheme sets the colors of the help overlay by the given theme.
+func (k *keyHelp) applyTheme(theme Theme) {
+	k.properties.borderColor = theme.Border
+	k.properties.sectionStyle = k.properties.sectionStyle.Foreground(theme.Border)
+}
+
+// setBorderSet sets the border of the help overlay by the frame of the given border set,
+// the separators and the ellipsis are drawn with the given glyphs.
+func (k *keyHelp) setBorderSet(set BorderSet, g glyphs) {
+	k.properties.border = set.Frame
+	k.model.ShortSeparator = g.separator
+	k.model.Ellipsis = g.ellipsis
+}
+
+// setMonochrome sets the section titles keep their bold attribute without the colors or not.
+func (k *keyHelp) setMonochrome(monochrome bool) {
+	k.properties.sectionStyle = k.properties.sectionStyle.Renderer(chromeRenderer(monochrome))
+}
+
+// Toggle shows or hides the help overlay.
+func (k *keyHelp) Toggle() {
+	k.active = !k.active
+}
+
+// Close hides the help overlay.
+func (k *keyHelp) Close() {
+	k.active = false
+}
+
+// IsActive returns the help overlay is shown or not.
+func (k *keyHelp) IsActive() bool {
+	return k.active
+}
+
+// SetShowShortHelp sets the short help row is shown above the widgets or not.
+func (k *keyHelp) SetShowShortHelp(show bool) {
+	k.showShortHelp = show
+}
+
+// GetShowShortHelp returns the short help row is shown above the widgets or not.
+func (k *keyHelp) GetShowShortHelp() bool {
+	return k.showShortHelp
+}
+
+// ShortView renders the short help row by the given width.
+func (k *keyHelp) ShortView(width int, bindings []key.Binding) string {
+	k.model.Width = width - 2 // for the padding
+	return lipgloss.NewStyle().PaddingLeft(1).Render(k.model.ShortHelpView(bindings))
+}
+
+// View renders the help overlay at the top center of the given area.
+func (k *keyHelp) View(width, height int, sections []helpSection) string {
+	boxWidth := min(k.properties.maxWidth, width-4)
+	innerWidth := boxWidth - 4 // for the border and the padding
+	if innerWidth <= 0 || height < 6 {
+		return ""
+	}
+
+	var blocks []string
+	for _, section := range sections {
+		view := k.fullHelpView(innerWidth, section.groups)
+		if view == "" {
+			continue
+		}
+		blocks = append(blocks, k.properties.sectionStyle.Render(section.title), view, "")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(strings.Join(blocks, "\n"), "\n"), "\n")
+	if maxLines := height - 4; len(lines) > maxLines { // for the top and bottom margins and the border
+		lines = append(lines[:maxLines-1], k.model.Styles.Ellipsis.Render(k.model.Ellipsis))
+	}
+
+	box := lipgloss.NewStyle().
+		Border(k.properties.border).
+		BorderForeground(k.properties.borderColor).
+		Padding(0, 1).
+		Width(boxWidth - 2).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Top, "\n"+box)
+}
+
+// fullHelpView renders the groups of the key bindings as columns, the columns are wrapped into rows by the given width.
+func (k *keyHelp) fullHelpView(width int, groups [][]key.Binding) string {
+	separator := k.model.Styles.FullSeparator.Render(k.model.FullSeparator)
+
+	var rows, row []string
+	var rowWidth int
+	for _, group := range groups {
+		column := k.columnView(group)
+		if column == "" {
+			continue
+		}
+
+		columnWidth := lipgloss.Width(column)
+		if len(row) > 0 && rowWidth+lipgloss.Width(separator)+columnWidth > width {
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+			row, rowWidth = nil, 0
+		}
+		if len(row) > 0 {
+			row = append(row, separator)
+			rowWidth += lipgloss.Width(separator)
+		}
+		row = append(row, column)
+		rowWidth += columnWidth
+	}
+	if len(row) > 0 {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// columnView renders the enabled key bindings of the group as a column.
+func (k *keyHelp) columnView(group []key.Binding) string {
+	var keys, descriptions []string
+	for _, binding := range group {
+		if binding.Enabled() {
+			keys = append(keys, binding.Help().Key)
+			descriptions = append(descriptions, binding.Help().Desc)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		k.model.Styles.FullKey.Render(strings.Join(keys, "\n")),
+		k.model.Styles.FullKey.Render(" "),
+		k.model.Styles.FullDesc.Render(strings.Join(descriptions, "\n")),
+	)
+}
+
+// bindingHelpKey returns the key text of the binding shown on the help.
+func bindingHelpKey(binding key.Binding) string {
+	if binding.Help().Key != "" {
+		return binding.Help().Key
+	}
+	return strings.Join(binding.Keys(), "/")
+}
+
+// ToggleHelp shows or hides the help overlay, it lists the global key bindings and the key bindings of the active page.
+// Pages implementing help.KeyMap contribute their own key bindings.
+func (s *Skeleton) ToggleHelp() *Skeleton {
+	s.overlay.Close()
+	s.keyHelp.Toggle()
+	s.triggerUpdate()
+	return s
+}
+
+// IsHelpShown returns the help overlay is shown or not.
+func (s *Skeleton) IsHelpShown() bool {
+	return s.keyHelp.IsActive()
+}
+
+// ShowShortHelp sets the short help row is shown above the widgets or not.
+// The row lists the short help of the active page followed by the short help of the global key bindings.
+func (s *Skeleton) ShowShortHelp(show bool) *Skeleton {
+	s.keyHelp.SetShowShortHelp(show)
+	s.triggerUpdate()
+	return s
+}
+
+// IsShortHelpShown returns the short help row is shown above the widgets or not.
+func (s *Skeleton) IsShortHelpShown() bool {
+	return s.keyHelp.GetShowShortHelp()
+}
+
+// commandHelp returns the key bindings of the commands owned by the given page key with their names as help.
+// Global commands are owned by the empty page key.
+func (s *Skeleton) commandHelp(pageKey string) []key.Binding {
+	var bindings []key.Binding
+	for _, c := range s.commands {
+		if c.page != pageKey || !c.binding.Enabled() {
+			continue
+		}
+
+		binding := c.binding
+		if binding.Help().Desc == "" {
+			binding.SetHelp(bindingHelpKey(binding), c.name)
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
+
+// helpSections returns the sections of the help overlay.
+func (s *Skeleton) helpSections() []helpSection {
+	globalGroups := s.KeyMap.FullHelp()
+	if commands := s.commandHelp(""); len(commands) > 0 {
+		globalGroups = append(globalGroups, commands)
+	}
+	sections := []helpSection{
+		{title: "Global", groups: globalGroups},
+	}
+	if s.focus != FocusPage {
+		sections = append(sections, helpSection{title: "Focus", groups: s.focusKeyMap.FullHelp()})
+	}
+
+	var pageGroups [][]key.Binding
+	if keyMap, ok := s.pages[s.currentTab].(help.KeyMap); ok {
+		pageGroups = append(pageGroups, keyMap.FullHelp()...)
+	}
+	if commands := s.commandHelp(s.GetActivePage()); len(commands) > 0 {
+		pageGroups = append(pageGroups, commands)
+	}
+	if len(pageGroups) > 0 {
+		sections = append(sections, helpSection{title: s.header.headers[s.currentTab].title, groups: pageGroups})
+	}
+
+	global := append(s.KeyMap.describedBindings(), s.commandBindings("")...)
+	var conflicts []key.Binding
+	for _, conflict := range findKeyConflicts(s.GetActivePage(), global, s.pageBindings(s.currentTab)) {
+		conflicts = append(conflicts, key.NewBinding(
+			key.WithKeys(conflict.Key),
+			key.WithHelp(conflict.Key, fmt.Sprintf("%s, taken by %s (%s)", conflict.Page, conflict.GlobalKey, conflict.Global)),
+		))
+	}
+	if len(conflicts) > 0 {
+		sections = append(sections, helpSection{title: "Conflicts", groups: [][]key.Binding{conflicts}})
+	}
+
+	return sections
+}
+
+// shortHelp returns the key bindings of the short help row.
+// While the tab bar or the status bar is focused, it returns the focus navigation key bindings.
+func (s *Skeleton) shortHelp() []key.Binding {
+	if s.focus != FocusPage {
+		return append(s.focusKeyMap.ShortHelp(), s.KeyMap.SwitchFocus, s.KeyMap.Help)
+	}
+
+	var bindings []key.Binding
+	if keyMap, ok := s.pages[s.currentTab].(help.KeyMap); ok {
+		bindings = append(bindings, keyMap.ShortHelp()...)
+	}
+	return append(bindings, s.KeyMap.ShortHelp()...)
+}
@@ -0,0 +1,161 @@
+package skeleton
+
+import (
+	"os"
+	"strings"
+)
+
+// iconEnv is the environment variable that selects the icon mode: nerd, unicode, ascii or none.
+const iconEnv = "SKELETON_ICONS"
+
+// IconMode is the kind of the glyphs the tab icons are drawn with.
+type IconMode int
+
+const (
+	// IconNerdFont draws the icons with the Nerd Font glyphs, the terminal font must be patched
+	IconNerdFont IconMode = iota
+
+	// IconUnicode draws the icons with the common Unicode symbols
+	IconUnicode
+
+	// IconASCII draws the icons with the ASCII characters only
+	IconASCII
+
+	// IconNone hides the icons
+	IconNone
+)
+
+// Icon is hold the glyphs of a tab icon, one for each icon mode.
+// Empty glyphs fall back to the next mode: Nerd Font, then Unicode, then ASCII.
+// The built-in Unicode glyphs are single width, they have no emoji presentation and no ambiguous width.
+type Icon struct {
+	NerdFont string
+	Unicode  string
+	ASCII    string
+}
+
+// glyph returns the glyph of the icon for the given mode, it is empty if the icon has no glyph for it.
+func (i Icon) glyph(mode IconMode) string {
+	if mode < IconNerdFont || mode >= IconNone {
+		return ""
+	}
+	for _, glyph := range []string{i.NerdFont, i.Unicode, i.ASCII}[mode:] {
+		if glyph != "" {
+			return glyph
+		}
+	}
+	return ""
+}
+
+// FileIcon returns the icon of a file.
+func FileIcon() Icon {
+	return Icon{NerdFont: "\uf15b", Unicode: "⎘", ASCII: "="}
+}
+
+// FolderIcon returns the icon of a folder.
+func FolderIcon() Icon {
+	return Icon{NerdFont: "\uf07b", Unicode: "▸", ASCII: "/"}
+}
+
+// GearIcon returns the icon of the settings.
+func GearIcon() Icon {
+	return Icon{NerdFont: "\uf013", Unicode: "✱", ASCII: "*"}
+}
+
+// TerminalIcon returns the icon of a terminal or a command output.
+func TerminalIcon() Icon {
+	return Icon{NerdFont: "\uf120", Unicode: "❯", ASCII: ">"}
+}
+
+// SearchIcon returns the icon of a search.
+func SearchIcon() Icon {
+	return Icon{NerdFont: "\uf002", Unicode: "⌕", ASCII: "?"}
+}
+
+// HomeIcon returns the icon of a home or an overview page.
+func HomeIcon() Icon {
+	return Icon{NerdFont: "\uf015", Unicode: "⌂", ASCII: "~"}
+}
+
+// LogIcon returns the icon of a log or a list.
+func LogIcon() Icon {
+	return Icon{NerdFont: "\uf03a", Unicode: "☰", ASCII: "#"}
+}
+
+// PageOption is an option of a page, it is given to AddPage.
+type PageOption func(*commonHeader)
+
+// WithIcon sets the icon rendered before the title of the tab.
+func WithIcon(icon Icon) PageOption {
+	return func(hdr *commonHeader) {
+		hdr.icon = icon
+	}
+}
+
+// defaultIconMode returns the icon mode chosen by the SKELETON_ICONS environment variable, or a guess by the terminal.
+// The guess is a heuristic, the installed fonts can not be detected: Nerd Font glyphs on WezTerm, which bundles them,
+// ASCII on the Linux console and Unicode elsewhere. SetIconMode is the reliable way to choose the glyphs.
+func defaultIconMode() IconMode {
+	switch strings.ToLower(os.Getenv(iconEnv)) {
+	case "nerd", "nerdfont":
+		return IconNerdFont
+	case "unicode":
+		return IconUnicode
+	case "ascii":
+		return IconASCII
+	case "none":
+		return IconNone
+	}
+
+	if os.Getenv("TERM_PROGRAM") == "WezTerm" {
+		return IconNerdFont
+	}
+	if os.Getenv("TERM") == "linux" {
+		return IconASCII
+	}
+	return IconUnicode
+}
+
+// SetIconMode sets the kind of the glyphs the tab icons are drawn with, it overrides the SKELETON_ICONS environment variable
+// and the guess by the terminal. Applications that ship Nerd Font icons should let the user opt in by it.
+// The icons are drawn with the ASCII characters in ASCII-only mode.
+func (s *Skeleton) SetIconMode(mode IconMode) *Skeleton {
+	s.properties.iconMode = mode
+	s.header.SetIconMode(s.chromeIconMode())
+	s.triggerUpdate()
+	return s
+}
+
+// GetIconMode returns the kind of the glyphs the tab icons are drawn with.
+func (s *Skeleton) GetIconMode() IconMode {
+	return s.properties.iconMode
+}
+
+// SetIconWidth sets the cells the glyphs of the given icon mode are drawn on, it is 1 by default.
+// The Nerd Font glyphs are measured as a single cell, but the non-Mono Nerd Fonts draw them on two cells;
+// the icon is followed by the spaces of the extra cells, so the glyph does not cover the title.
+func (s *Skeleton) SetIconWidth(mode IconMode, width int) *Skeleton {
+	s.header.SetIconWidth(mode, width)
+	s.triggerUpdate()
+	return s
+}
+
+// GetIconWidth returns the cells the glyphs of the given icon mode are drawn on.
+func (s *Skeleton) GetIconWidth(mode IconMode) int {
+	return s.header.GetIconWidth(mode)
+}
+
+// SetPageIcon sets the icon of the page by the given key, the zero Icon removes it.
+func (s *Skeleton) SetPageIcon(key string, icon Icon) *Skeleton {
+	s.header.SetIcon(key, icon)
+	s.triggerUpdate()
+	return s
+}
+
+// chromeIconMode returns the icon mode the tabs are drawn with.
+func (s *Skeleton) chromeIconMode() IconMode {
+	if s.properties.asciiOnly && s.properties.iconMode < IconASCII {
+		return IconASCII
+	}
+	return s.properties.iconMode
+}
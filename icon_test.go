@@ -0,0 +1,76 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+func TestIconGlyph(t *testing.T) {
+	full := Icon{NerdFont: "", Unicode: "⎘", ASCII: "="}
+	asciiOnly := Icon{ASCII: "="}
+
+	tests := []struct {
+		name string
+		icon Icon
+		mode IconMode
+		want string
+	}{
+		{"nerd font", full, IconNerdFont, ""},
+		{"unicode", full, IconUnicode, "⎘"},
+		{"ascii", full, IconASCII, "="},
+		{"none", full, IconNone, ""},
+		{"falls back", asciiOnly, IconNerdFont, "="},
+		{"zero icon", Icon{}, IconUnicode, ""},
+		{"unknown mode", full, IconMode(-1), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.icon.glyph(tt.mode); got != tt.want {
+				t.Errorf("glyph(%v) = %q, want %q", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuiltinIconsAreSingleWidth(t *testing.T) {
+	for _, icon := range []Icon{FileIcon(), FolderIcon(), GearIcon(), TerminalIcon(), SearchIcon(), HomeIcon(), LogIcon()} {
+		for mode := IconNerdFont; mode < IconNone; mode++ {
+			if glyph := icon.glyph(mode); lipgloss.Width(glyph) != 1 {
+				t.Errorf("glyph %q of mode %v is %d cells wide", glyph, mode, lipgloss.Width(glyph))
+			}
+		}
+	}
+}
+
+func TestIconWidth(t *testing.T) {
+	h := newHeader()
+	h.SetIconMode(IconNerdFont)
+	h.SetCloseButton(true)
+	h.AddCommonHeader("files", "Files", WithIcon(FileIcon()))
+	h.AddCommonHeader("logs", "Logs")
+	h.Update(tea.WindowSizeMsg{Width: 60, Height: 10})
+
+	narrow := h.titleLength
+	h.SetIconWidth(IconNerdFont, 2)
+	if h.titleLength != narrow+1 {
+		t.Errorf("title length = %d, want the extra cell of the icon counted after %d", h.titleLength, narrow)
+	}
+	if title := h.displayTitle(0, h.headers[0]); !strings.HasPrefix(title, "\uf15b  Files") {
+		t.Errorf("title = %q, want the icon followed by its extra cell and the separator", title)
+	}
+
+	// the close glyph is hit at the column it is rendered on
+	line := strings.Split(ansi.Strip(h.View()), "\n")[1]
+	column := lipgloss.Width(line[:strings.Index(line, h.properties.glyphs.close)])
+	if index, onClose, ok := h.TabAt(column, 1); !ok || index != 0 || !onClose {
+		t.Errorf("TabAt(%d) = %d, %v, %v, want the close glyph of the first tab", column, index, onClose, ok)
+	}
+
+	if h.SetIconWidth(IconNone, 3); h.GetIconWidth(IconNone) != 0 {
+		t.Error("IconNone has a width")
+	}
+}
@@ -1,22 +1,89 @@
 package skeleton
 
 import (
+	"fmt"
 	teakey "github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 type keyMap struct {
 	SwitchTabRight teakey.Binding
 	SwitchTabLeft  teakey.Binding
+	FirstTab       teakey.Binding
+	LastTab        teakey.Binding
+	NextTabGroup   teakey.Binding
+	ToggleSidebar  teakey.Binding
+	Zoom           teakey.Binding
+	JumpToTab      []teakey.Binding
+	SearchTab      teakey.Binding
+	CloseTab       teakey.Binding
+	OpenPalette    teakey.Binding
+	Help           teakey.Binding
+	SwitchFocus    teakey.Binding
 	Quit           teakey.Binding
+
+	// chordTimeout is hold the time to wait for the next key of a key sequence (chord)
+	chordTimeout time.Duration
+
+	// chordPending is hold the keys of the incomplete key sequence
+	chordPending []tea.KeyMsg
+
+	// chordID is increased on every key of a key sequence, it is used to ignore the stale timeouts
+	chordID int
+
+	// rangeGlyph is hold the glyph between the first and the last key of the key ranges on the help, e.g. alt+1…alt+9
+	rangeGlyph string
 }
 
 const (
 	keymapSwitchTabRight = "ctrl+right"
 	keymapSwitchTabLeft  = "ctrl+left"
+	keymapFirstTab       = "ctrl+home"
+	keymapLastTab        = "ctrl+end"
+	keymapNextTabGroup   = "ctrl+g"
+	keymapToggleSidebar  = "ctrl+b"
+	keymapZoom           = "alt+z"
+	keymapJumpToTab      = "alt+%d"
+	keymapSearchTab      = "ctrl+f"
+	keymapCloseTab       = "ctrl+w"
+	keymapOpenPalette    = "ctrl+p"
+	keymapHelp           = "?"
+	keymapSwitchFocus    = "f6"
 	keymapQuit           = "ctrl+c"
 )
 
+// KeyAction is the name of a built-in key binding.
+type KeyAction string
+
+const (
+	KeyActionNextTab     KeyAction = "next_tab"
+	KeyActionPrevTab     KeyAction = "prev_tab"
+	KeyActionFirstTab    KeyAction = "first_tab"
+	KeyActionLastTab     KeyAction = "last_tab"
+	KeyActionNextGroup   KeyAction = "next_tab_group"
+	KeyActionSidebar     KeyAction = "toggle_sidebar"
+	KeyActionZoom        KeyAction = "toggle_zoom"
+	KeyActionSearchTab   KeyAction = "search_tab"
+	KeyActionCloseTab    KeyAction = "close_tab"
+	KeyActionOpenPalette KeyAction = "open_palette"
+	KeyActionHelp        KeyAction = "toggle_help"
+	KeyActionSwitchFocus KeyAction = "switch_focus"
+	KeyActionQuit        KeyAction = "quit"
+
+	// KeyActionJumpToTab is the prefix of the jump to tab actions, e.g. "jump_to_tab_1" jumps to the first tab.
+	KeyActionJumpToTab KeyAction = "jump_to_tab_"
+)
+
+// jumpToTabCount is the count of the direct tab jump bindings (alt+1 … alt+9)
+const jumpToTabCount = 9
+
+// defaultChordTimeout is the default time to wait for the next key of a key sequence
+const defaultChordTimeout = time.Second
+
 var (
 	onceKeyMap sync.Once
 	varKeyMap  *keyMap
@@ -27,18 +94,219 @@ func newKeyMap() *keyMap {
 		varKeyMap = &keyMap{
 			SwitchTabRight: teakey.NewBinding(
 				teakey.WithKeys(keymapSwitchTabRight),
+				teakey.WithHelp(keymapSwitchTabRight, "next tab"),
 			),
 			SwitchTabLeft: teakey.NewBinding(
 				teakey.WithKeys(keymapSwitchTabLeft),
+				teakey.WithHelp(keymapSwitchTabLeft, "previous tab"),
+			),
+			FirstTab: teakey.NewBinding(
+				teakey.WithKeys(keymapFirstTab),
+				teakey.WithHelp(keymapFirstTab, "first tab"),
+			),
+			LastTab: teakey.NewBinding(
+				teakey.WithKeys(keymapLastTab),
+				teakey.WithHelp(keymapLastTab, "last tab"),
+			),
+			NextTabGroup: teakey.NewBinding(
+				teakey.WithKeys(keymapNextTabGroup),
+				teakey.WithHelp(keymapNextTabGroup, "next tab group"),
+			),
+			ToggleSidebar: teakey.NewBinding(
+				teakey.WithKeys(keymapToggleSidebar),
+				teakey.WithHelp(keymapToggleSidebar, "collapse sidebar"),
+			),
+			Zoom: teakey.NewBinding(
+				teakey.WithKeys(keymapZoom),
+				teakey.WithHelp(keymapZoom, "zoom page"),
+			),
+			JumpToTab: newJumpToTabBindings(),
+			SearchTab: teakey.NewBinding(
+				teakey.WithKeys(keymapSearchTab),
+				teakey.WithHelp(keymapSearchTab, "search tab"),
+			),
+			CloseTab: teakey.NewBinding(
+				teakey.WithKeys(keymapCloseTab),
+				teakey.WithHelp(keymapCloseTab, "close tab"),
+			),
+			OpenPalette: teakey.NewBinding(
+				teakey.WithKeys(keymapOpenPalette),
+				teakey.WithHelp(keymapOpenPalette, "command palette"),
+			),
+			Help: teakey.NewBinding(
+				teakey.WithKeys(keymapHelp),
+				teakey.WithHelp(keymapHelp, "toggle help"),
+			),
+			SwitchFocus: teakey.NewBinding(
+				teakey.WithKeys(keymapSwitchFocus),
+				teakey.WithHelp(keymapSwitchFocus, "focus tabs/status bar"),
 			),
 			Quit: teakey.NewBinding(
 				teakey.WithKeys(keymapQuit),
+				teakey.WithHelp(keymapQuit, "quit"),
 			),
+			chordTimeout: defaultChordTimeout,
+			rangeGlyph:   "…",
 		}
 	})
 	return varKeyMap
 }
 
+// newJumpToTabBindings returns the default direct tab jump bindings.
+func newJumpToTabBindings() []teakey.Binding {
+	bindings := make([]teakey.Binding, jumpToTabCount)
+	for i := range bindings {
+		keys := fmt.Sprintf(keymapJumpToTab, i+1)
+		bindings[i] = teakey.NewBinding(
+			teakey.WithKeys(keys),
+			teakey.WithHelp(keys, fmt.Sprintf("jump to tab %d", i+1)),
+		)
+	}
+	return bindings
+}
+
+// ShortHelp returns the key bindings shown on the short help row, it implements help.KeyMap.
+func (k *keyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.SwitchTabLeft, k.SwitchTabRight, k.OpenPalette, k.Help}
+}
+
+// FullHelp returns the key bindings shown on the help overlay, it implements help.KeyMap.
+func (k *keyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{
+		{k.SwitchTabLeft, k.SwitchTabRight, k.FirstTab, k.LastTab},
+		{k.jumpToTabHelp(), k.NextTabGroup, k.SearchTab, k.CloseTab, k.ToggleSidebar},
+		{k.OpenPalette, k.Help, k.SwitchFocus, k.Zoom, k.Quit},
+	}
+}
+
+// binding returns the built-in key binding by the given action, it returns nil for unknown actions
+// and for the jump to tab bindings that are not created yet. It does not change the key map.
+func (k *keyMap) binding(action KeyAction) *teakey.Binding {
+	switch action {
+	case KeyActionNextTab:
+		return &k.SwitchTabRight
+	case KeyActionPrevTab:
+		return &k.SwitchTabLeft
+	case KeyActionFirstTab:
+		return &k.FirstTab
+	case KeyActionLastTab:
+		return &k.LastTab
+	case KeyActionNextGroup:
+		return &k.NextTabGroup
+	case KeyActionSidebar:
+		return &k.ToggleSidebar
+	case KeyActionZoom:
+		return &k.Zoom
+	case KeyActionSearchTab:
+		return &k.SearchTab
+	case KeyActionCloseTab:
+		return &k.CloseTab
+	case KeyActionOpenPalette:
+		return &k.OpenPalette
+	case KeyActionHelp:
+		return &k.Help
+	case KeyActionSwitchFocus:
+		return &k.SwitchFocus
+	case KeyActionQuit:
+		return &k.Quit
+	}
+
+	index, ok := k.jumpToTabIndex(action)
+	if !ok || index > len(k.JumpToTab) {
+		return nil
+	}
+	return &k.JumpToTab[index-1]
+}
+
+// createBinding returns the built-in key binding by the given action like binding,
+// the missing jump to tab bindings are created disabled.
+func (k *keyMap) createBinding(action KeyAction) *teakey.Binding {
+	if index, ok := k.jumpToTabIndex(action); ok && index > len(k.JumpToTab) {
+		k.SetKeyJumpToTab(index-1, teakey.NewBinding(teakey.WithDisabled()))
+	}
+	return k.binding(action)
+}
+
+// isKeyAction returns the action is one of the built-in key bindings or not, including the jump to tab bindings
+// that are not created yet.
+func (k *keyMap) isKeyAction(action KeyAction) bool {
+	if _, ok := k.jumpToTabIndex(action); ok {
+		return true
+	}
+	return k.binding(action) != nil
+}
+
+// jumpToTabIndex returns the tab number of the jump to tab action, e.g. 3 for "jump_to_tab_3".
+// ok is false if the action is not a jump to tab action or the number is out of range.
+func (k *keyMap) jumpToTabIndex(action KeyAction) (index int, ok bool) {
+	suffix, ok := strings.CutPrefix(string(action), string(KeyActionJumpToTab))
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 1 || index > max(len(k.JumpToTab), jumpToTabCount) {
+		return 0, false
+	}
+	return index, true
+}
+
+// keyActions returns the actions of all the built-in key bindings.
+func (k *keyMap) keyActions() []KeyAction {
+	actions := []KeyAction{
+		KeyActionNextTab, KeyActionPrevTab, KeyActionFirstTab, KeyActionLastTab, KeyActionNextGroup, KeyActionSidebar, KeyActionZoom,
+		KeyActionSearchTab, KeyActionCloseTab, KeyActionOpenPalette, KeyActionHelp, KeyActionSwitchFocus, KeyActionQuit,
+	}
+	for i := range k.JumpToTab {
+		actions = append(actions, KeyAction(fmt.Sprintf("%s%d", KeyActionJumpToTab, i+1)))
+	}
+	return actions
+}
+
+// DisableKey disables the built-in key binding by the given action entirely, it is neither matched nor shown on the help.
+// It returns false for unknown actions.
+func (k *keyMap) DisableKey(action KeyAction) bool {
+	binding := k.createBinding(action)
+	if binding == nil {
+		return false
+	}
+
+	binding.SetEnabled(false)
+	return true
+}
+
+// EnableKey enables the built-in key binding by the given action again.
+// It returns false for unknown actions.
+func (k *keyMap) EnableKey(action KeyAction) bool {
+	binding := k.createBinding(action)
+	if binding == nil {
+		return false
+	}
+
+	binding.SetEnabled(true)
+	return true
+}
+
+// jumpToTabHelp returns a single binding that summarizes the enabled jump to tab bindings on the help.
+func (k *keyMap) jumpToTabHelp() teakey.Binding {
+	var keys []string
+	for _, binding := range k.JumpToTab {
+		if binding.Enabled() {
+			keys = append(keys, bindingHelpKey(binding))
+		}
+	}
+
+	switch len(keys) {
+	case 0:
+		return teakey.NewBinding(teakey.WithDisabled())
+	case 1:
+		return teakey.NewBinding(teakey.WithKeys(keys...), teakey.WithHelp(keys[0], "jump to tab"))
+	}
+	return teakey.NewBinding(
+		teakey.WithKeys(keys...),
+		teakey.WithHelp(fmt.Sprintf("%s%s%s", keys[0], k.rangeGlyph, keys[len(keys)-1]), "jump to tab"),
+	)
+}
+
 // --------------------------------------------
 
 func (k *keyMap) SetKeyNextTab(keybinding teakey.Binding) {
@@ -53,6 +321,57 @@ func (k *keyMap) SetKeyQuit(keybinding teakey.Binding) {
 	k.Quit = keybinding
 }
 
+func (k *keyMap) SetKeyFirstTab(keybinding teakey.Binding) {
+	k.FirstTab = keybinding
+}
+
+func (k *keyMap) SetKeyLastTab(keybinding teakey.Binding) {
+	k.LastTab = keybinding
+}
+
+func (k *keyMap) SetKeyNextTabGroup(keybinding teakey.Binding) {
+	k.NextTabGroup = keybinding
+}
+
+func (k *keyMap) SetKeyToggleSidebar(keybinding teakey.Binding) {
+	k.ToggleSidebar = keybinding
+}
+
+func (k *keyMap) SetKeyZoom(keybinding teakey.Binding) {
+	k.Zoom = keybinding
+}
+
+func (k *keyMap) SetKeyCloseTab(keybinding teakey.Binding) {
+	k.CloseTab = keybinding
+}
+
+func (k *keyMap) SetKeySwitchFocus(keybinding teakey.Binding) {
+	k.SwitchFocus = keybinding
+}
+
+// SetKeyJumpToTab sets the key binding that jumps to the tab at the given index (zero based).
+func (k *keyMap) SetKeyJumpToTab(index int, keybinding teakey.Binding) {
+	if index < 0 {
+		return
+	}
+	for len(k.JumpToTab) <= index {
+		k.JumpToTab = append(k.JumpToTab, teakey.NewBinding(teakey.WithDisabled()))
+	}
+	k.JumpToTab[index] = keybinding
+}
+
+func (k *keyMap) SetKeySearchTab(keybinding teakey.Binding) {
+	k.SearchTab = keybinding
+}
+
+func (k *keyMap) SetKeyOpenPalette(keybinding teakey.Binding) {
+	k.OpenPalette = keybinding
+}
+
+func (k *keyMap) SetKeyHelp(keybinding teakey.Binding) {
+	k.Help = keybinding
+}
+
 func (k *keyMap) GetKeyNextTab() teakey.Binding {
 	return k.SwitchTabRight
 }
@@ -64,3 +383,267 @@ func (k *keyMap) GetKeyPrevTab() teakey.Binding {
 func (k *keyMap) GetKeyQuit() teakey.Binding {
 	return k.Quit
 }
+
+func (k *keyMap) GetKeyFirstTab() teakey.Binding {
+	return k.FirstTab
+}
+
+func (k *keyMap) GetKeyLastTab() teakey.Binding {
+	return k.LastTab
+}
+
+func (k *keyMap) GetKeyNextTabGroup() teakey.Binding {
+	return k.NextTabGroup
+}
+
+func (k *keyMap) GetKeyToggleSidebar() teakey.Binding {
+	return k.ToggleSidebar
+}
+
+func (k *keyMap) GetKeyZoom() teakey.Binding {
+	return k.Zoom
+}
+
+func (k *keyMap) GetKeyCloseTab() teakey.Binding {
+	return k.CloseTab
+}
+
+func (k *keyMap) GetKeySwitchFocus() teakey.Binding {
+	return k.SwitchFocus
+}
+
+// GetKeyJumpToTab returns the key binding that jumps to the tab at the given index (zero based).
+func (k *keyMap) GetKeyJumpToTab(index int) teakey.Binding {
+	if index < 0 || index >= len(k.JumpToTab) {
+		return teakey.NewBinding(teakey.WithDisabled())
+	}
+	return k.JumpToTab[index]
+}
+
+func (k *keyMap) GetKeySearchTab() teakey.Binding {
+	return k.SearchTab
+}
+
+func (k *keyMap) GetKeyOpenPalette() teakey.Binding {
+	return k.OpenPalette
+}
+
+func (k *keyMap) GetKeyHelp() teakey.Binding {
+	return k.Help
+}
+
+// --------------------------------------------
+// Key sequences (chords)
+//
+// A key of a binding may be a sequence of keys separated by spaces, e.g. "ctrl+a n" or "g t".
+// The keys of an incomplete sequence are held until the sequence is completed, broken or timed out.
+// Broken and timed out sequences are replayed as single key presses.
+
+// chordState is the state of the chord engine after a key press.
+type chordState int
+
+const (
+	// chordIdle means the key is not a part of any key sequence
+	chordIdle chordState = iota
+
+	// chordPending means the key sequence is incomplete, the next key is expected
+	chordPending
+
+	// chordMatched means the key sequence is completed
+	chordMatched
+
+	// chordBroken means the keys don't match any key sequence, the held keys should be replayed
+	chordBroken
+)
+
+// chordTimeoutMsg is sent when the time to wait for the next key of a key sequence is over.
+type chordTimeoutMsg struct {
+	id int
+}
+
+// chordKey returns the key of the key press as it is written in the key sequences.
+func chordKey(msg tea.KeyMsg) string {
+	if msg.Type == tea.KeySpace {
+		return "space"
+	}
+	return msg.String()
+}
+
+// normalizeKeys normalizes the key of a binding, the keys of a sequence are separated by a single space.
+func normalizeKeys(keys string) string {
+	fields := strings.Fields(keys)
+	if len(fields) == 0 {
+		return "space" // the space key itself
+	}
+	return strings.Join(fields, " ")
+}
+
+// isChord returns the key of a binding is a key sequence or not.
+func isChord(keys string) bool {
+	return len(strings.Fields(keys)) > 1
+}
+
+// matches returns the binding is bound to the given key or key sequence.
+func (k *keyMap) matches(keys string, binding teakey.Binding) bool {
+	if !binding.Enabled() {
+		return false
+	}
+	for _, bindingKeys := range binding.Keys() {
+		if normalizeKeys(bindingKeys) == keys {
+			return true
+		}
+	}
+	return false
+}
+
+// feedChord feeds the key press to the chord engine by the given key sequences.
+// It returns the completed key sequence or the key itself, and the state of the engine.
+func (k *keyMap) feedChord(msg tea.KeyMsg, sequences []string) (string, chordState) {
+	typed := make([]string, 0, len(k.chordPending)+1)
+	for _, pending := range k.chordPending {
+		typed = append(typed, chordKey(pending))
+	}
+	typed = append(typed, chordKey(msg))
+	keys := strings.Join(typed, " ")
+
+	var prefix bool
+	for _, sequence := range sequences {
+		if len(typed) > 1 && sequence == keys {
+			k.chordPending = nil
+			return keys, chordMatched
+		}
+		if strings.HasPrefix(sequence, keys+" ") {
+			prefix = true
+		}
+	}
+
+	if prefix {
+		k.chordPending = append(k.chordPending, msg)
+		k.chordID++
+		return keys, chordPending
+	}
+	if len(k.chordPending) > 0 {
+		return "", chordBroken
+	}
+	return chordKey(msg), chordIdle
+}
+
+// takeChord returns the held keys of the incomplete key sequence and resets the chord engine.
+func (k *keyMap) takeChord() []tea.KeyMsg {
+	pending := k.chordPending
+	k.chordPending = nil
+	return pending
+}
+
+// isChordTimeout returns the timeout belongs to the current incomplete key sequence or not.
+func (k *keyMap) isChordTimeout(msg chordTimeoutMsg) bool {
+	return len(k.chordPending) > 0 && msg.id == k.chordID
+}
+
+// chordTimeoutCmd returns the command that times out the current incomplete key sequence.
+func (k *keyMap) chordTimeoutCmd() tea.Cmd {
+	id := k.chordID
+	return tea.Tick(k.chordTimeout, func(time.Time) tea.Msg {
+		return chordTimeoutMsg{id: id}
+	})
+}
+
+// PendingChord returns the keys of the incomplete key sequence, it is empty if there is no such sequence.
+func (k *keyMap) PendingChord() string {
+	typed := make([]string, len(k.chordPending))
+	for i, pending := range k.chordPending {
+		typed[i] = chordKey(pending)
+	}
+	return strings.Join(typed, " ")
+}
+
+// SetChordTimeout sets the time to wait for the next key of a key sequence.
+func (k *keyMap) SetChordTimeout(timeout time.Duration) {
+	k.chordTimeout = timeout
+}
+
+// GetChordTimeout returns the time to wait for the next key of a key sequence.
+func (k *keyMap) GetChordTimeout() time.Duration {
+	return k.chordTimeout
+}
+
+// --------------------------------------------
+// Key conflicts
+
+// KeyConflict is hold a key that is bound by a global key binding and a key binding of a page at the same time.
+// A key sequence conflicts with the keys it starts with as well, e.g. "g t" conflicts with "g".
+type KeyConflict struct {
+	// PageKey is the key of the page that owns the conflicting key binding
+	PageKey string
+
+	// Key is the conflicting key of the page key binding
+	Key string
+
+	// GlobalKey is the conflicting key of the global key binding
+	GlobalKey string
+
+	// Global is the help description of the global key binding
+	Global string
+
+	// Page is the help description of the page key binding
+	Page string
+}
+
+// Error returns the conflict as text, it implements the error interface.
+func (c KeyConflict) Error() string {
+	return fmt.Sprintf("skeleton: key %q (%s) of page %q conflicts with global key %q (%s)",
+		c.Key, c.Page, c.PageKey, c.GlobalKey, c.Global)
+}
+
+// keysConflict returns the given keys or key sequences conflict or not.
+func keysConflict(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+" ") || strings.HasPrefix(b, a+" ")
+}
+
+// describedBinding is hold a key binding with its help description.
+type describedBinding struct {
+	binding     teakey.Binding
+	description string
+}
+
+// describedBindings returns the enabled built-in key bindings with their help descriptions.
+func (k *keyMap) describedBindings() []describedBinding {
+	var bindings []describedBinding
+	for _, action := range k.keyActions() {
+		binding := *k.binding(action)
+		if !binding.Enabled() {
+			continue
+		}
+
+		description := binding.Help().Desc
+		if description == "" {
+			description = string(action)
+		}
+		bindings = append(bindings, describedBinding{binding: binding, description: description})
+	}
+	return bindings
+}
+
+// findKeyConflicts returns the conflicts between the global and the page key bindings.
+func findKeyConflicts(pageKey string, global, page []describedBinding) []KeyConflict {
+	var conflicts []KeyConflict
+	for _, p := range page {
+		for _, pageKeys := range p.binding.Keys() {
+			for _, g := range global {
+				for _, globalKeys := range g.binding.Keys() {
+					if !keysConflict(normalizeKeys(pageKeys), normalizeKeys(globalKeys)) {
+						continue
+					}
+					conflicts = append(conflicts, KeyConflict{
+						PageKey:   pageKey,
+						Key:       normalizeKeys(pageKeys),
+						GlobalKey: normalizeKeys(globalKeys),
+						Global:    g.description,
+						Page:      p.description,
+					})
+				}
+			}
+		}
+	}
+	return conflicts
+}
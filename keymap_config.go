@@ -0,0 +1,279 @@
+package skeleton
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LoadKeyMap reads the key bindings from the reader and applies them.
+// The input maps the action names (see KeyAction) to the key lists, either as JSON:
+//
+//	{"next_tab": ["ctrl+right", "alt+l"], "close_tab": []}
+//
+// or as TOML-like text:
+//
+//	# tab navigation
+//	next_tab = ["ctrl+right", "alt+l"]
+//	prev_tab = "ctrl+left"
+//	close_tab = []
+//
+// An empty key list disables the binding, the actions that are not mentioned are kept as they are.
+// Unknown actions and keys bound to more than one action are reported, nothing is applied on error.
+func (k *keyMap) LoadKeyMap(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("skeleton: could not read keymap: %w", err)
+	}
+
+	var entries []keyMapEntry
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		entries, err = parseJSONKeyMap(trimmed)
+	} else {
+		entries, err = parseTextKeyMap(data)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateKeyMap(entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		binding := k.createBinding(entry.action)
+		binding.SetKeys(entry.keys...)
+		binding.SetHelp(strings.Join(entry.keys, "/"), binding.Help().Desc)
+		binding.SetEnabled(len(entry.keys) > 0)
+	}
+	return nil
+}
+
+// DumpKeyMap writes the current key bindings to the writer as JSON, LoadKeyMap reads it back.
+// Disabled bindings are written as empty key lists.
+func (k *keyMap) DumpKeyMap(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("{\n")
+
+	actions := k.keyActions()
+	for i, action := range actions {
+		keys := []string{}
+		if binding := k.binding(action); binding.Enabled() {
+			keys = binding.Keys()
+		}
+
+		value, err := json.Marshal(keys)
+		if err != nil {
+			return fmt.Errorf("skeleton: could not encode keys of %q: %w", action, err)
+		}
+
+		fmt.Fprintf(&b, "  %q: %s", action, value)
+		if i < len(actions)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// keyMapEntry is hold the keys of an action read from a keymap file.
+type keyMapEntry struct {
+	action KeyAction
+	keys   []string
+
+	// line is the line of the entry in the TOML-like text, it is zero for JSON
+	line int
+}
+
+// String returns the position of the entry for the error messages.
+func (e keyMapEntry) String() string {
+	if e.line > 0 {
+		return fmt.Sprintf("line %d: %q", e.line, e.action)
+	}
+	return fmt.Sprintf("%q", e.action)
+}
+
+// validateKeyMap checks the entries against the current key bindings, it does not change the key map.
+// It reports unknown actions, actions listed twice, empty keys and keys bound to more than one action.
+func (k *keyMap) validateKeyMap(entries []keyMapEntry) error {
+	var errs []error
+
+	loaded := make(map[KeyAction]keyMapEntry)
+	for _, entry := range entries {
+		if !k.isKeyAction(entry.action) {
+			errs = append(errs, fmt.Errorf("skeleton: keymap %s: unknown action", entry))
+			continue
+		}
+		if previous, ok := loaded[entry.action]; ok {
+			errs = append(errs, fmt.Errorf("skeleton: keymap %s: action is already set at %s", entry, previous))
+			continue
+		}
+		for _, keys := range entry.keys {
+			if strings.TrimSpace(keys) == "" && keys != " " {
+				errs = append(errs, fmt.Errorf("skeleton: keymap %s: empty key", entry))
+			}
+		}
+		loaded[entry.action] = entry
+	}
+
+	// actions of the resulting keymap, the loaded jump to tab bindings may not be created yet
+	actions := k.keyActions()
+	for _, entry := range entries {
+		if _, ok := loaded[entry.action]; ok && k.binding(entry.action) == nil {
+			actions = append(actions, entry.action)
+		}
+	}
+
+	// keys of the resulting keymap, the loaded entries override the current bindings
+	owners := make(map[string]KeyAction)
+	for _, action := range actions {
+		var keys []string
+		if binding := k.binding(action); binding != nil && binding.Enabled() {
+			keys = binding.Keys()
+		}
+		if entry, ok := loaded[action]; ok {
+			keys = entry.keys
+		}
+
+		for _, key := range keys {
+			normalized := normalizeKeys(key)
+			if owner, ok := owners[normalized]; ok && owner != action {
+				errs = append(errs, fmt.Errorf("skeleton: keymap: key %q is bound to both %q and %q", normalized, owner, action))
+				continue
+			}
+			owners[normalized] = action
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// parseJSONKeyMap parses the JSON keymap, the values are key lists or single keys.
+func parseJSONKeyMap(data []byte) ([]keyMapEntry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	if _, err := decoder.Token(); err != nil { // opening brace
+		return nil, fmt.Errorf("skeleton: invalid keymap JSON: %w", err)
+	}
+
+	var entries []keyMapEntry
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("skeleton: invalid keymap JSON: %w", err)
+		}
+		action, _ := token.(string)
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("skeleton: invalid keymap JSON at %q: %w", action, err)
+		}
+
+		var keys []string
+		if err := json.Unmarshal(value, &keys); err != nil {
+			var single string
+			if json.Unmarshal(value, &single) != nil {
+				return nil, fmt.Errorf("skeleton: keymap %q: keys must be a string or a list of strings", action)
+			}
+			keys = []string{single}
+		}
+
+		entries = append(entries, keyMapEntry{action: KeyAction(action), keys: keys})
+	}
+
+	if _, err := decoder.Token(); err != nil { // closing brace
+		return nil, fmt.Errorf("skeleton: invalid keymap JSON: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("skeleton: invalid keymap JSON: unexpected data after the keymap")
+	}
+
+	return entries, nil
+}
+
+// parseTextKeyMap parses the TOML-like keymap, one `action = keys` pair per line.
+// The keys are a quoted string or a list of quoted strings, lines starting with # are comments.
+// An optional [keymap] table header is allowed.
+func parseTextKeyMap(data []byte) ([]keyMapEntry, error) {
+	var entries []keyMapEntry
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || text == "[keymap]" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("skeleton: keymap line %d: expected `action = keys`", line)
+		}
+
+		keys, err := parseTextKeys(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("skeleton: keymap line %d: %w", line, err)
+		}
+
+		action := strings.Trim(strings.TrimSpace(name), `"'`)
+		entries = append(entries, keyMapEntry{action: KeyAction(action), keys: keys, line: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("skeleton: could not read keymap: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseTextKeys parses a quoted string or a list of quoted strings followed by an optional comment.
+func parseTextKeys(value string) ([]string, error) {
+	list := strings.HasPrefix(value, "[")
+	if list {
+		value = value[1:]
+	}
+
+	var keys []string
+	for {
+		value = strings.TrimLeft(value, " \t,")
+		if value == "" {
+			if list {
+				return nil, errors.New("unterminated key list")
+			}
+			return nil, errors.New("missing keys")
+		}
+
+		if list && value[0] == ']' {
+			value = value[1:]
+			break
+		}
+
+		quote := value[0]
+		if quote != '"' && quote != '\'' {
+			return nil, fmt.Errorf("keys must be quoted, got %q", value)
+		}
+		end := strings.IndexByte(value[1:], quote)
+		if end < 0 {
+			return nil, errors.New("unterminated quoted key")
+		}
+		keys = append(keys, value[1:end+1])
+		value = value[end+2:]
+
+		if !list {
+			break
+		}
+	}
+
+	if rest := strings.TrimSpace(value); rest != "" && !strings.HasPrefix(rest, "#") {
+		return nil, fmt.Errorf("unexpected %q after the keys", rest)
+	}
+	if keys == nil {
+		keys = []string{}
+	}
+	return keys, nil
+}
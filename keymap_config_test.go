@@ -0,0 +1,148 @@
+package skeleton
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseJSONKeyMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []keyMapEntry
+		wantErr bool
+	}{
+		{"list", `{"next_tab": ["ctrl+right", "alt+l"]}`, []keyMapEntry{{action: KeyActionNextTab, keys: []string{"ctrl+right", "alt+l"}}}, false},
+		{"single key", `{"prev_tab": "ctrl+left"}`, []keyMapEntry{{action: KeyActionPrevTab, keys: []string{"ctrl+left"}}}, false},
+		{"empty list", `{"close_tab": []}`, []keyMapEntry{{action: KeyActionCloseTab, keys: []string{}}}, false},
+		{"empty", `{}`, nil, false},
+		{"number", `{"next_tab": 1}`, nil, true},
+		{"unterminated", `{"next_tab": "x"`, nil, true},
+		{"trailing object", `{"next_tab": "x"} {"prev_tab": "y"}`, nil, true},
+		{"trailing garbage", `{"next_tab": "x"} junk`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseJSONKeyMap([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if !equalEntries(got, tt.want) {
+				t.Errorf("entries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTextKeyMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []keyMapEntry
+		wantErr bool
+	}{
+		{"pairs", "[keymap]\n# tabs\nnext_tab = [\"ctrl+right\", 'alt+l']\nprev_tab = \"ctrl+left\" # back\n",
+			[]keyMapEntry{
+				{action: KeyActionNextTab, keys: []string{"ctrl+right", "alt+l"}, line: 3},
+				{action: KeyActionPrevTab, keys: []string{"ctrl+left"}, line: 4},
+			}, false},
+		{"empty list", "close_tab = []", []keyMapEntry{{action: KeyActionCloseTab, keys: []string{}, line: 1}}, false},
+		{"space key", `toggle_help = " "`, []keyMapEntry{{action: KeyActionHelp, keys: []string{" "}, line: 1}}, false},
+		{"missing equals", "next_tab ctrl+right", nil, true},
+		{"unquoted", "next_tab = ctrl+right", nil, true},
+		{"unterminated list", `next_tab = ["ctrl+right"`, nil, true},
+		{"trailing data", `next_tab = "ctrl+right" "alt+l"`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTextKeyMap([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if !equalEntries(got, tt.want) {
+				t.Errorf("entries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateKeyMapIsReadOnly(t *testing.T) {
+	k := &keyMap{JumpToTab: newJumpToTabBindings()[:3]}
+	k.SwitchTabRight.SetKeys("ctrl+right")
+
+	entries := []keyMapEntry{
+		{action: KeyActionJumpToTab + "5", keys: []string{"ctrl+right"}},
+		{action: "unknown", keys: []string{"x"}},
+	}
+	err := k.validateKeyMap(entries)
+	if err == nil || !strings.Contains(err.Error(), "unknown action") || !strings.Contains(err.Error(), "bound to both") {
+		t.Errorf("err = %v, want the unknown action and the key conflict of the new binding", err)
+	}
+	if len(k.JumpToTab) != 3 {
+		t.Errorf("jump to tab bindings = %d, want the key map unchanged", len(k.JumpToTab))
+	}
+
+	if err := k.LoadKeyMap(strings.NewReader(`{"jump_to_tab_5": "alt+0"}`)); err != nil {
+		t.Fatalf("LoadKeyMap() = %v", err)
+	}
+	if len(k.JumpToTab) != 5 || !k.JumpToTab[4].Enabled() || k.JumpToTab[3].Enabled() {
+		t.Errorf("jump to tab bindings = %d, want the fifth one created and the missing ones disabled", len(k.JumpToTab))
+	}
+}
+
+func TestDumpKeyMapIsReadByLoadKeyMap(t *testing.T) {
+	dumped := &keyMap{JumpToTab: newJumpToTabBindings()[:2]}
+	dumped.SwitchTabRight.SetKeys("ctrl+right", "alt+l")
+	dumped.CloseTab.SetKeys("ctrl+w")
+	dumped.CloseTab.SetEnabled(false)
+
+	var b strings.Builder
+	if err := dumped.DumpKeyMap(&b); err != nil {
+		t.Fatalf("DumpKeyMap() = %v", err)
+	}
+
+	loaded := &keyMap{}
+	loaded.CloseTab.SetKeys("ctrl+x")
+	if err := loaded.LoadKeyMap(strings.NewReader(b.String())); err != nil {
+		t.Fatalf("LoadKeyMap() = %v\n%s", err, b.String())
+	}
+	if got := strings.Join(loaded.SwitchTabRight.Keys(), " "); got != "ctrl+right alt+l" {
+		t.Errorf("next tab keys = %q, want the dumped keys", got)
+	}
+	if loaded.CloseTab.Enabled() {
+		t.Error("close tab is enabled, want the disabled binding dumped as an empty list")
+	}
+	if len(loaded.JumpToTab) != 2 || loaded.JumpToTab[1].Keys()[0] != "alt+2" {
+		t.Errorf("jump to tab bindings = %d, want the dumped ones", len(loaded.JumpToTab))
+	}
+}
+
+func TestLoadKeyMapAppliesNothingOnError(t *testing.T) {
+	k := &keyMap{}
+	k.SwitchTabRight.SetKeys("ctrl+right")
+
+	err := k.LoadKeyMap(strings.NewReader("next_tab = \"alt+l\"\nprev_tab = \"alt+l\"\n"))
+	if err == nil || !strings.Contains(err.Error(), "bound to both") {
+		t.Fatalf("err = %v, want the key conflict", err)
+	}
+	if got := strings.Join(k.SwitchTabRight.Keys(), " "); got != "ctrl+right" {
+		t.Errorf("next tab keys = %q, want the key map unchanged", got)
+	}
+}
+
+func equalEntries(a, b []keyMapEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].action != b[i].action || a[i].line != b[i].line || strings.Join(a[i].keys, "\x00") != strings.Join(b[i].keys, "\x00") {
+			return false
+		}
+		if (a[i].keys == nil) != (b[i].keys == nil) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestFeedChord(t *testing.T) {
+	sequences := []string{"g t", "g g", "ctrl+a n"}
+
+	tests := []struct {
+		name  string
+		keys  []tea.KeyMsg
+		want  string
+		state chordState
+	}{
+		{"single key", []tea.KeyMsg{runes("x")}, "x", chordIdle},
+		{"first key of a sequence", []tea.KeyMsg{runes("g")}, "g", chordPending},
+		{"completed sequence", []tea.KeyMsg{runes("g"), runes("t")}, "g t", chordMatched},
+		{"repeated key", []tea.KeyMsg{runes("g"), runes("g")}, "g g", chordMatched},
+		{"modifier key", []tea.KeyMsg{{Type: tea.KeyCtrlA}, runes("n")}, "ctrl+a n", chordMatched},
+		{"broken sequence", []tea.KeyMsg{runes("g"), runes("x")}, "", chordBroken},
+		{"space", []tea.KeyMsg{{Type: tea.KeySpace}}, "space", chordIdle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &keyMap{}
+			var got string
+			var state chordState
+			for _, msg := range tt.keys {
+				got, state = k.feedChord(msg, sequences)
+			}
+			if got != tt.want || state != tt.state {
+				t.Errorf("feedChord() = %q, %v, want %q, %v", got, state, tt.want, tt.state)
+			}
+		})
+	}
+}
+
+func TestChordTimeout(t *testing.T) {
+	k := &keyMap{}
+	k.feedChord(runes("g"), []string{"g t"})
+	stale := chordTimeoutMsg{id: k.chordID - 1}
+	current := chordTimeoutMsg{id: k.chordID}
+
+	if k.isChordTimeout(stale) {
+		t.Error("the stale timeout times out the current key sequence")
+	}
+	if !k.isChordTimeout(current) {
+		t.Error("the current timeout does not time out the key sequence")
+	}
+	if k.takeChord(); k.isChordTimeout(current) {
+		t.Error("the timeout is accepted without a held key sequence")
+	}
+}
+
+func TestBrokenChordStartsAnotherSequence(t *testing.T) {
+	s, rec := newTestSkeleton("first", "second")
+	// the key map is shared by the skeletons
+	defaultKeys := s.KeyMap.SwitchTabRight.Keys()
+	t.Cleanup(func() { s.KeyMap.SwitchTabRight.SetKeys(defaultKeys...) })
+	s.KeyMap.SwitchTabRight.SetKeys("x t")
+
+	// the second "x" breaks the first sequence and starts another one
+	for _, msg := range []tea.KeyMsg{runes("x"), runes("x"), runes("t")} {
+		s.updateKey(msg)
+	}
+
+	if len(rec.keys) != 1 || rec.keys[0] != "x" {
+		t.Errorf("page keys = %q, want the first held key replayed only", rec.keys)
+	}
+	if s.header.GetCurrentTab() != 1 {
+		t.Errorf("current tab = %d, want the second sequence to switch the tab", s.header.GetCurrentTab())
+	}
+	if s.KeyMap.PendingChord() != "" {
+		t.Errorf("pending keys = %q, want none", s.KeyMap.PendingChord())
+	}
+}
+
+func TestGlobalBindingsConsumeKeys(t *testing.T) {
+	s, rec := newTestSkeleton("first", "second", "third")
+
+	tests := []struct {
+		msg tea.KeyMsg
+		tab int
+	}{
+		{tea.KeyMsg{Type: tea.KeyCtrlRight}, 1},
+		{tea.KeyMsg{Type: tea.KeyCtrlLeft}, 0},
+		{tea.KeyMsg{Type: tea.KeyCtrlEnd}, 2},
+		{tea.KeyMsg{Type: tea.KeyCtrlHome}, 0},
+		{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2"), Alt: true}, 1},
+	}
+	for _, tt := range tests {
+		s.updateKey(tt.msg)
+		if s.header.GetCurrentTab() != tt.tab {
+			t.Errorf("%s: current tab = %d, want %d", tt.msg, s.header.GetCurrentTab(), tt.tab)
+		}
+	}
+
+	s.updateKey(runes("x"))
+	if len(rec.keys) != 1 || rec.keys[0] != "x" {
+		t.Errorf("page keys = %q, want the unbound key only", rec.keys)
+	}
+}
+
+func TestHelpKeyOpensTheHelp(t *testing.T) {
+	s, rec := newTestSkeleton("first")
+
+	s.updateKey(runes("?"))
+	if !s.keyHelp.IsActive() || len(rec.keys) != 0 {
+		t.Errorf("help is active %v, page keys %q, want the help key to open the help", s.keyHelp.IsActive(), rec.keys)
+	}
+}
+
+func TestExclusiveInputPageTypesTheHelpKey(t *testing.T) {
+	s, rec := newTestSkeleton()
+	s.AddPage("input", "Input", inputRecorder{keyRecorder{rec}})
+
+	s.updateKey(runes("?"))
+	if s.keyHelp.IsActive() || len(rec.keys) != 1 || rec.keys[0] != "?" {
+		t.Errorf("help is active %v, page keys %q, want the page to type the key", s.keyHelp.IsActive(), rec.keys)
+	}
+}
@@ -0,0 +1,42 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetTabBarPosition sets the tab bar is rendered on the top (lipgloss.Top) or on the bottom (lipgloss.Bottom) of the frame,
+// the status bar takes the other side. Tabs on the bottom are familiar to the screen and tmux users.
+// The other positions are treated as lipgloss.Top.
+func (s *Skeleton) SetTabBarPosition(position lipgloss.Position) *Skeleton {
+	if position != lipgloss.Bottom {
+		position = lipgloss.Top
+	}
+
+	statusBar := lipgloss.Bottom
+	if position == lipgloss.Bottom {
+		statusBar = lipgloss.Top
+	}
+
+	s.header.SetPosition(position)
+	s.widget.SetPosition(statusBar)
+	s.triggerUpdate()
+	return s
+}
+
+// GetTabBarPosition returns the tab bar is rendered on the top or on the bottom of the frame.
+func (s *Skeleton) GetTabBarPosition() lipgloss.Position {
+	return s.header.GetPosition()
+}
+
+// isTabBarOnTop returns the tab bar is rendered on the top of the frame or not.
+func (s *Skeleton) isTabBarOnTop() bool {
+	return s.header.GetPosition() != lipgloss.Bottom
+}
+
+// bodyTop returns the row the page area starts, it is below the bar on the top of the frame.
+func (s *Skeleton) bodyTop() int {
+	if s.isTabBarOnTop() {
+		return s.header.Height()
+	}
+	return s.widget.Height()
+}
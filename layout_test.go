@@ -0,0 +1,64 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+func TestTabBarPositionView(t *testing.T) {
+	tests := []struct {
+		name        string
+		position    lipgloss.Position
+		widget      bool
+		bodyTop     int
+		tabRow      int
+		frameTop    int
+		frameBottom int
+	}{
+		{"top", lipgloss.Top, true, 3, 1, 1, 10},
+		{"top without widgets", lipgloss.Top, false, 3, 1, 1, 11},
+		{"bottom", lipgloss.Bottom, true, 3, 10, 1, 10},
+		{"bottom without widgets", lipgloss.Bottom, false, 2, 10, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mouse []tea.MouseMsg
+			s := NewSkeleton()
+			s.SetTabBarPosition(tt.position)
+			s.AddPage("table", "Table", linesPage{count: 3, mouse: &mouse})
+			if tt.widget {
+				s.Update(AddNewWidget{Key: "clock", Value: "12:00"})
+			}
+			s.Update(tea.WindowSizeMsg{Width: 40, Height: 12})
+			settle(s)
+
+			view := s.View()
+			lines := strings.Split(ansi.Strip(view), "\n")
+			if len(lines) != 12 {
+				t.Fatalf("view has %d rows, want the terminal height:\n%s", len(lines), ansi.Strip(view))
+			}
+			if got := s.bodyTop(); got != tt.bodyTop {
+				t.Errorf("bodyTop = %d, want %d", got, tt.bodyTop)
+			}
+			if _, y := cellOf(t, view, "line "); y != s.bodyTop() {
+				t.Errorf("page starts on row %d, want bodyTop %d:\n%s", y, s.bodyTop(), ansi.Strip(view))
+			}
+			if _, y := cellOf(t, view, "Table"); y != tt.tabRow {
+				t.Errorf("tab is on row %d, want %d:\n%s", y, tt.tabRow, ansi.Strip(view))
+			}
+
+			// the corners of the frame are drawn on the rows of the bars
+			top, bottom := lines[tt.frameTop], lines[tt.frameBottom]
+			if !strings.HasPrefix(top, "╭") || !strings.HasSuffix(strings.TrimRight(top, " "), "╮") {
+				t.Errorf("top row of the frame = %q, want the top corners", top)
+			}
+			if !strings.HasPrefix(bottom, "╰") || !strings.HasSuffix(strings.TrimRight(bottom, " "), "╯") {
+				t.Errorf("bottom row of the frame = %q, want the bottom corners", bottom)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"hash/fnv"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// monitorTickInterval is the interval the views of the monitored pages are checked by.
+const monitorTickInterval = 500 * time.Millisecond
+
+// monitorTickMsg is sent to check the views of the monitored pages.
+type monitorTickMsg struct{}
+
+// MonitorAlert is the notification sent to the terminal when a monitored page has activity or silence.
+type MonitorAlert int
+
+const (
+	// AlertNone marks the tab only
+	AlertNone MonitorAlert = iota
+
+	// AlertBell rings the terminal bell
+	AlertBell
+
+	// AlertOSC9 sends a desktop notification by the OSC 9 escape sequence, e.g. on iTerm2, WezTerm and Windows Terminal
+	AlertOSC9
+)
+
+// monitorMark is the mark of a monitored tab.
+type monitorMark int
+
+const (
+	markNone monitorMark = iota
+	markActivity
+	markSilence
+)
+
+// pageMonitor is hold the monitoring settings and the last seen view of a page.
+type pageMonitor struct {
+	activity   bool
+	silence    time.Duration
+	viewHash   uint64
+	lastChange time.Time
+	silent     bool // the silence is already reported, it is reported again after the next activity
+	mark       monitorMark
+}
+
+// MonitorActivity sets the tab of the page by the given key is marked when its view changes in the background or not.
+// It works like the monitor-activity option of tmux, the mark is removed when the page is activated.
+func (s *Skeleton) MonitorActivity(key string, monitor bool) *Skeleton {
+	s.header.SetMonitor(key, func(m *pageMonitor) {
+		m.activity = monitor
+	})
+	s.triggerUpdate()
+	return s
+}
+
+// MonitorSilence sets the tab of the page by the given key is marked when its view does not change for the given duration.
+// It works like the monitor-silence option of tmux, zero duration disables it. The mark is removed when the page is activated.
+func (s *Skeleton) MonitorSilence(key string, after time.Duration) *Skeleton {
+	s.header.SetMonitor(key, func(m *pageMonitor) {
+		m.silence = after
+		m.silent = false
+	})
+	s.triggerUpdate()
+	return s
+}
+
+// SetMonitorAlert sets the notification sent to the terminal when a monitored page is marked, it is AlertNone by default.
+// The notifications are sent only after an output is set by SetMonitorAlertOutput.
+func (s *Skeleton) SetMonitorAlert(alert MonitorAlert) *Skeleton {
+	s.properties.monitorAlert = alert
+	return s
+}
+
+// SetMonitorAlertOutput sets the output the notifications are written to, nil (the default) sends no notification.
+// The notifications are written by a command apart from the renderer of the program, so an output the program
+// renders to (e.g. os.Stdout) may interleave them with a frame. The caller opts in by setting the output explicitly.
+func (s *Skeleton) SetMonitorAlertOutput(output io.Writer) *Skeleton {
+	s.properties.alertOutput = output
+	return s
+}
+
+// GetMonitorAlert returns the notification sent to the terminal when a monitored page is marked.
+func (s *Skeleton) GetMonitorAlert() MonitorAlert {
+	return s.properties.monitorAlert
+}
+
+// scheduleMonitorTick starts the tick of the monitoring if a page is monitored and the tick is not running.
+func (s *Skeleton) scheduleMonitorTick(cmds []tea.Cmd) []tea.Cmd {
+	if s.monitorTicking || !s.header.HasMonitor() {
+		return cmds
+	}
+
+	s.monitorTicking = true
+	return append(cmds, tea.Tick(monitorTickInterval, func(time.Time) tea.Msg {
+		return monitorTickMsg{}
+	}))
+}
+
+// updateMonitorTick checks the views of the monitored pages and marks the tabs of the background pages.
+// The active page is only tracked, so switching away from it does not mark it.
+func (s *Skeleton) updateMonitorTick(cmds []tea.Cmd) []tea.Cmd {
+	s.monitorTicking = false
+	now := time.Now()
+
+	for i := range s.header.headers {
+		hdr := &s.header.headers[i]
+		m := &hdr.monitor
+		if !m.activity && m.silence == 0 {
+			continue
+		}
+
+		// the first view is the baseline, it is not an activity
+		hash := viewHash(s.pages[i].View())
+		first := m.lastChange.IsZero()
+		changed := !first && hash != m.viewHash
+		if changed || first {
+			m.viewHash = hash
+			m.lastChange = now
+			m.silent = false
+		}
+
+		if i == s.currentTab {
+			continue
+		}
+
+		var mark monitorMark
+		switch {
+		case changed && m.activity && m.mark != markActivity:
+			mark = markActivity
+		case !changed && m.silence > 0 && !m.silent && now.Sub(m.lastChange) >= m.silence:
+			m.silent = true
+			mark = markSilence
+		default:
+			continue
+		}
+
+		m.mark = mark
+		s.header.calculateTitleLength()
+		cmds = append(cmds, s.monitorAlertCmd(hdr.title, mark))
+	}
+
+	return s.scheduleMonitorTick(cmds)
+}
+
+// monitorAlertCmd returns the command that sends the notification of the mark to the terminal.
+func (s *Skeleton) monitorAlertCmd(title string, mark monitorMark) tea.Cmd {
+	var sequence string
+	switch s.properties.monitorAlert {
+	case AlertBell:
+		sequence = "\a"
+	case AlertOSC9:
+		event := "Activity"
+		if mark == markSilence {
+			event = "Silence"
+		}
+		sequence = fmt.Sprintf("\x1b]9;%s in %s\a", event, sanitizeAlertText(title))
+	default:
+		return nil
+	}
+
+	output := s.properties.alertOutput
+	if output == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		_, _ = io.WriteString(output, sequence)
+		return nil
+	}
+}
+
+// sanitizeAlertText removes the escape sequences and the control characters from the text of a notification,
+// so the text can not end the escape sequence of the notification early or inject another one.
+func sanitizeAlertText(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, ansi.Strip(text))
+}
+
+// viewHash returns the hash of the view of a page.
+func viewHash(view string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(view))
+	return hash.Sum64()
+}
@@ -0,0 +1,50 @@
+package skeleton
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSanitizeAlertText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", "Logs", "Logs"},
+		{"unicode", "Günlük ✓", "Günlük ✓"},
+		{"injected sequence", "Logs\a\x1b]9;injected\a", "Logs"},
+		{"styled", "\x1b[31mLogs\x1b[0m", "Logs"},
+		{"c1 control", "Logs\u009c", "Logs"},
+		{"line breaks", "Build\r\nlog", "Buildlog"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeAlertText(tt.text); got != tt.want {
+				t.Errorf("sanitizeAlertText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitorAlertOutput(t *testing.T) {
+	var output bytes.Buffer
+	s := NewSkeleton().SetMonitorAlert(AlertOSC9).SetMonitorAlertOutput(&output)
+
+	s.monitorAlertCmd("Logs\a", markSilence)()
+	if got, want := output.String(), "\x1b]9;Silence in Logs\a"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+
+	if cmd := s.SetMonitorAlert(AlertNone).monitorAlertCmd("Logs", markActivity); cmd != nil {
+		t.Error("a notification is sent without an alert")
+	}
+}
+
+func TestMonitorAlertNeedsAnOutput(t *testing.T) {
+	s := NewSkeleton().SetMonitorAlert(AlertBell)
+	if cmd := s.monitorAlertCmd("Logs", markActivity); cmd != nil {
+		t.Error("a notification is sent without an output")
+	}
+}
@@ -0,0 +1,154 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShowTabCloseButton sets the close glyph is rendered on the closable tabs or not.
+// Clicking the glyph closes the tab, pinned tabs and the last tab have no close glyph.
+func (s *Skeleton) ShowTabCloseButton(show bool) *Skeleton {
+	s.header.SetCloseButton(show)
+	s.triggerUpdate()
+	return s
+}
+
+// IsTabCloseButtonShown returns the close glyph is rendered on the closable tabs or not.
+func (s *Skeleton) IsTabCloseButtonShown() bool {
+	return s.header.GetCloseButton()
+}
+
+// OnWidgetClick sets the callback of the widget of the status bar by the given key, it is called when the widget is clicked.
+// The returned command is executed by the program, nil handler removes the callback.
+func (s *Skeleton) OnWidgetClick(key string, handler func() tea.Cmd) *Skeleton {
+	s.widget.SetClickHandler(key, handler)
+	return s
+}
+
+// updateMouse handles the mouse events, the program should be started with tea.WithMouseCellMotion.
+// Clicking a tab activates it, middle-click or clicking the close glyph closes it and the wheel over the tab bar cycles the tabs.
+// Clicking a widget of the status bar or the header calls its callback. The events over the page area are sent to the active page
+// with the coordinates relative to the page content.
+func (s *Skeleton) updateMouse(msg tea.MouseMsg, cmds []tea.Cmd) []tea.Cmd {
+	if s.overlay.IsActive() || s.keyHelp.IsActive() {
+		return cmds
+	}
+	if s.zoomed {
+		// the active page takes the whole terminal but the row of the zoom hint
+		msg.Y -= s.zoomHintHeight()
+		if msg.Y < 0 {
+			return cmds
+		}
+		return s.updateActivePage(msg, cmds)
+	}
+
+	bodyTop, bodyHeight := s.bodyTop(), s.bodyHeight()
+	footerTop := bodyTop + bodyHeight
+	if s.keyHelp.GetShowShortHelp() {
+		footerTop++
+	}
+
+	switch {
+	case msg.Y < bodyTop:
+		return s.updateBarMouse(msg, s.isTabBarOnTop(), cmds)
+	case msg.Y >= footerTop:
+		msg.Y -= footerTop
+		return s.updateBarMouse(msg, !s.isTabBarOnTop(), cmds)
+	case msg.Y >= bodyTop+bodyHeight || msg.X < 1 || msg.X > s.viewport.Width-2:
+		// the short help row and the side borders are not the part of the page
+		return cmds
+	case msg.X <= s.sidebarWidth():
+		return s.updateSidebarMouse(msg, msg.Y-bodyTop, cmds)
+	}
+
+	frameStyle := s.properties.frameStyle
+	left, _ := frameSize(frameStyle.UnsetBorderStyle())
+	msg.X -= 1 + s.sidebarWidth() + left // for the left border, the sidebar, the margin and the padding of the frame style
+	msg.Y -= bodyTop + frameStyle.GetMarginTop() + frameStyle.GetPaddingTop()
+	return s.updateActivePage(msg, cmds)
+}
+
+// updateActivePage sends the mouse event to the active page only.
+// The update channel is not listened to again, the motion events would pile up the listeners otherwise.
+func (s *Skeleton) updateActivePage(msg tea.MouseMsg, cmds []tea.Cmd) []tea.Cmd {
+	var cmd tea.Cmd
+	s.pages[s.currentTab], cmd = s.pages[s.currentTab].Update(msg)
+	return append(cmds, cmd)
+}
+
+// updateBarMouse handles the mouse events over the tab bar or the status bar, the position is relative to the bar.
+func (s *Skeleton) updateBarMouse(msg tea.MouseMsg, tabBar bool, cmds []tea.Cmd) []tea.Cmd {
+	if tabBar {
+		return s.updateHeaderMouse(msg, cmds)
+	}
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return cmds
+	}
+	if key, ok := s.widget.WidgetAt(msg.X, msg.Y); ok {
+		cmds = append(cmds, s.widget.Click(key))
+	}
+	return cmds
+}
+
+// updateSidebarMouse handles the mouse events over the sidebar, the row is relative to the sidebar.
+// Clicking a tab activates it, middle-click closes it and the wheel cycles the tabs.
+func (s *Skeleton) updateSidebarMouse(msg tea.MouseMsg, row int, cmds []tea.Cmd) []tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return cmds
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		return s.switchPage(cmds, "left")
+	case tea.MouseButtonWheelDown:
+		return s.switchPage(cmds, "right")
+	}
+
+	index, ok := s.header.SidebarTabAt(row)
+	if !ok {
+		return cmds
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonMiddle:
+		return s.closePage(cmds, s.header.headers[index].key)
+	case tea.MouseButtonLeft:
+		return s.jumpToPage(cmds, index)
+	}
+
+	return cmds
+}
+
+// updateHeaderMouse handles the mouse events over the tab bar.
+func (s *Skeleton) updateHeaderMouse(msg tea.MouseMsg, cmds []tea.Cmd) []tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return cmds
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp, tea.MouseButtonWheelLeft:
+		return s.switchPage(cmds, "left")
+	case tea.MouseButtonWheelDown, tea.MouseButtonWheelRight:
+		return s.switchPage(cmds, "right")
+	}
+
+	if key, ok := s.header.WidgetAt(msg.X, msg.Y); ok {
+		if msg.Button == tea.MouseButtonLeft {
+			cmds = append(cmds, s.header.Click(key))
+		}
+		return cmds
+	}
+
+	index, onClose, ok := s.header.TabAt(msg.X, msg.Y)
+	if !ok {
+		return cmds
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonMiddle, msg.Button == tea.MouseButtonLeft && onClose:
+		return s.closePage(cmds, s.header.headers[index].key)
+	case msg.Button == tea.MouseButtonLeft:
+		return s.jumpToPage(cmds, index)
+	}
+
+	return cmds
+}
@@ -0,0 +1,106 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+func TestPageMouseEventsDoNotListenAgain(t *testing.T) {
+	var mouse []tea.MouseMsg
+	s := NewSkeleton()
+	s.AddPage("table", "Table", linesPage{count: 30, mouse: &mouse})
+	s.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+	settle(s)
+
+	_, cmd := s.Update(tea.MouseMsg{X: 5, Y: 5, Action: tea.MouseActionMotion})
+	if len(mouse) != 1 {
+		t.Fatalf("mouse events = %v, want the motion sent to the page", mouse)
+	}
+	if cmd != nil {
+		t.Error("a forwarded mouse event returns a command, want no listener of the update channel")
+	}
+}
+
+// cellOf returns the column and the row of the first cell of the text on the view.
+func cellOf(t *testing.T, view, text string) (x, y int) {
+	t.Helper()
+	for y, line := range strings.Split(ansi.Strip(view), "\n") {
+		if i := strings.Index(line, text); i >= 0 {
+			return ansi.StringWidth(line[:i]), y
+		}
+	}
+	t.Fatalf("view has no %q:\n%s", text, view)
+	return 0, 0
+}
+
+func click(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+func TestClickTab(t *testing.T) {
+	for _, position := range []lipgloss.Position{lipgloss.Top, lipgloss.Bottom} {
+		s, _ := newTestSkeleton("first", "second", "third")
+		s.SetTabBarPosition(position)
+		s.Update(AddNewWidget{Key: "clock", Value: "12:00"})
+		s.Update(tea.WindowSizeMsg{Width: 60, Height: 12})
+		settle(s)
+
+		x, y := cellOf(t, s.View(), "second")
+		s.Update(click(x, y))
+		if s.GetActivePage() != "second" {
+			t.Errorf("position %v: active page = %q, want the clicked tab", position, s.GetActivePage())
+		}
+
+		var clicks int
+		s.OnWidgetClick("clock", func() tea.Cmd { clicks++; return nil })
+		x, y = cellOf(t, s.View(), "12:00")
+		s.Update(click(x, y))
+		if clicks != 1 {
+			t.Errorf("position %v: widget clicks = %d, want the clicked widget", position, clicks)
+		}
+	}
+}
+
+func TestClickScrolledTab(t *testing.T) {
+	var keys []string
+	for i := 0; i < 12; i++ {
+		keys = append(keys, fmt.Sprintf("page-%d", i))
+	}
+	s, _ := newTestSkeleton(keys...)
+	s.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	settle(s)
+
+	x, y := cellOf(t, s.View(), "page-10")
+	if index, _, ok := s.header.TabAt(x, y); !ok || index != 10 {
+		t.Errorf("tab at %d, %d = %d, %v, want the scrolled tab", x, y, index, ok)
+	}
+	s.Update(click(x, y))
+	if s.GetActivePage() != "page-10" {
+		t.Errorf("active page = %q, want the clicked tab", s.GetActivePage())
+	}
+
+	x, y = cellOf(t, s.View(), "more")
+	if index, _, ok := s.header.TabAt(x, y); !ok || index != s.header.offset-1 {
+		t.Errorf("tab at the overflow indicator = %d, %v, want the nearest hidden tab", index, ok)
+	}
+}
+
+func TestHeaderWidgetAt(t *testing.T) {
+	s, _ := newTestSkeleton("first")
+	s.Update(AddNewHeaderWidget{Key: "env", Value: "staging"})
+	s.Update(tea.WindowSizeMsg{Width: 60, Height: 10})
+	settle(s)
+
+	x, y := cellOf(t, s.View(), "staging")
+	if key, ok := s.header.WidgetAt(x, y); !ok || key != "env" {
+		t.Errorf("widget at %d, %d = %q, %v, want the header widget", x, y, key, ok)
+	}
+	if _, ok := s.header.WidgetAt(x, y+3); ok {
+		t.Error("a widget is found below the header")
+	}
+}
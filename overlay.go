@@ -0,0 +1,227 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"strings"
+)
+
+// overlay is a helper for rendering a filterable list on top of the active page.
+type overlay struct {
+	// active is control the overlay is shown or not
+	active bool
+
+	// prompt is hold the text shown before the query
+	prompt string
+
+	// query is hold the filter typed by the user
+	query []rune
+
+	// cursor is hold the index of the selected match
+	cursor int
+
+	// items are hold the selectable items of the overlay
+	items []overlayItem
+
+	// matches are hold the indexes of the items matching the query, best match first
+	matches []int
+
+	// properties are hold the properties of the overlay
+	properties *overlayProperties
+}
+
+// newOverlay returns a new overlay.
+func newOverlay() *overlay {
+	return &overlay{
+		properties: defaultOverlayProperties(),
+	}
+}
+
+// overlayItem is hold the overlay item required fields.
+type overlayItem struct {
+	// title is the main text of the item, it is used for filtering
+	title string
+
+	// description is the secondary text of the item, it is shown next to the title
+	description string
+
+	// detail is the text shown on the right side of the item, e.g. the key or the key binding
+	detail string
+
+	// run is called when the item is selected
+	run func() tea.Cmd
+}
+
+// overlayProperties are hold the properties of the overlay.
+type overlayProperties struct {
+	borderColor   lipgloss.TerminalColor
+	border        lipgloss.Border
+	pointer       string
+	maxWidth      int
+	promptStyle   lipgloss.Style
+	itemStyle     lipgloss.Style
+	detailStyle   lipgloss.Style
+	selectedStyle lipgloss.Style
+}
+
+// defaultOverlayProperties returns the default properties of the overlay.
+func defaultOverlayProperties() *overlayProperties {
+	theme := DefaultTheme()
+	return &overlayProperties{
+		borderColor:   theme.Border,
+		border:        RoundedBorderSet().Frame,
+		pointer:       unicodeGlyphs.pointer,
+		maxWidth:      60,
+		promptStyle:   lipgloss.NewStyle().Foreground(theme.Border),
+		itemStyle:     lipgloss.NewStyle().Foreground(theme.Text),
+		detailStyle:   lipgloss.NewStyle().Foreground(theme.Muted),
+		selectedStyle: lipgloss.NewStyle().Foreground(theme.Accent).Bold(true),
+	}
+}
+
+// Open shows the overlay with the given prompt and items.
+func (o *overlay) Open(prompt string, items []overlayItem) {
+	o.active = true
+	o.prompt = prompt
+	o.items = items
+	o.query = nil
+	o.filter()
+}
+
+// Close hides the overlay.
+func (o *overlay) Close() {
+	o.active = false
+	o.items = nil
+	o.matches = nil
+	o.query = nil
+}
+
+// IsActive returns the overlay is shown or not.
+func (o *overlay) IsActive() bool {
+	return o.active
+}
+
+// SetBorderColor sets the border color and the prompt color of the overlay.
+func (o *overlay) SetBorderColor(color string) {
+	o.properties.borderColor = lipgloss.Color(color)
+	o.properties.promptStyle = o.properties.promptStyle.Foreground(lipgloss.Color(color))
+}
+
+// applyTheme sets the colors of the overlay by the given theme.
+func (o *overlay) applyTheme(theme Theme) {
+	o.properties.borderColor = theme.Border
+	o.properties.promptStyle = o.properties.promptStyle.Foreground(theme.Border)
+	o.properties.itemStyle = o.properties.itemStyle.Foreground(theme.Text)
+	o.properties.detailStyle = o.properties.detailStyle.Foreground(theme.Muted)
+	o.properties.selectedStyle = o.properties.selectedStyle.Foreground(theme.Accent)
+}
+
+// setBorderSet sets the border of the overlay by the frame of the given border set, the markers are drawn with the given glyphs.
+func (o *overlay) setBorderSet(set BorderSet, g glyphs) {
+	o.properties.border = set.Frame
+	o.properties.pointer = g.pointer
+}
+
+// setMonochrome sets the selected item is reversed instead of colored or not.
+func (o *overlay) setMonochrome(monochrome bool) {
+	o.properties.selectedStyle = o.properties.selectedStyle.Renderer(chromeRenderer(monochrome)).Reverse(monochrome)
+}
+
+// filter refreshes the matches by the current query.
+func (o *overlay) filter() {
+	candidates := make([][]string, len(o.items))
+	for i, item := range o.items {
+		candidates[i] = []string{item.title, item.description, item.detail}
+	}
+
+	o.matches = fuzzyFilter(string(o.query), candidates)
+	o.cursor = 0
+}
+
+// Update handles the key presses while the overlay is shown.
+func (o *overlay) Update(msg tea.KeyMsg) (*overlay, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		o.Close()
+	case tea.KeyEnter:
+		if o.cursor >= len(o.matches) {
+			return o, nil
+		}
+		item := o.items[o.matches[o.cursor]]
+		o.Close()
+		if item.run != nil {
+			return o, item.run()
+		}
+	case tea.KeyUp, tea.KeyCtrlP, tea.KeyShiftTab:
+		o.cursor = max(o.cursor-1, 0)
+	case tea.KeyDown, tea.KeyCtrlN, tea.KeyTab:
+		o.cursor = max(min(o.cursor+1, len(o.matches)-1), 0)
+	case tea.KeyBackspace:
+		if len(o.query) > 0 {
+			o.query = o.query[:len(o.query)-1]
+			o.filter()
+		}
+	case tea.KeyCtrlU:
+		o.query = nil
+		o.filter()
+	case tea.KeySpace:
+		o.query = append(o.query, ' ')
+		o.filter()
+	case tea.KeyRunes:
+		o.query = append(o.query, msg.Runes...)
+		o.filter()
+	}
+
+	return o, nil
+}
+
+// View renders the overlay at the top center of the given area, so it doesn't jump while filtering.
+func (o *overlay) View(width, height int) string {
+	boxWidth := min(o.properties.maxWidth, width-4)
+	innerWidth := boxWidth - 4 // for the border and the padding
+	if innerWidth <= 0 || height < 7 {
+		return ""
+	}
+
+	var lines []string
+	lines = append(lines, o.properties.promptStyle.Render(o.prompt+" "+o.properties.pointer+" ")+string(o.query)+"_", "")
+
+	visible := height - 6 // for the top and bottom margins, the border, the prompt and the blank line
+	offset := 0
+	if o.cursor >= visible {
+		offset = o.cursor - visible + 1
+	}
+
+	if len(o.matches) == 0 {
+		lines = append(lines, o.properties.detailStyle.Render("no match"))
+	}
+	for i := offset; i < len(o.matches) && i < offset+visible; i++ {
+		item := o.items[o.matches[i]]
+
+		marker, style := "  ", o.properties.itemStyle
+		if i == o.cursor {
+			marker, style = o.properties.pointer+" ", o.properties.selectedStyle
+		}
+
+		title := style.Render(marker + item.title)
+		if item.description != "" {
+			title += o.properties.detailStyle.Render("  " + item.description)
+		}
+		detail := o.properties.detailStyle.Render(item.detail)
+		gap := innerWidth - lipgloss.Width(title) - lipgloss.Width(detail)
+		if gap < 1 {
+			lines = append(lines, lipgloss.NewStyle().MaxWidth(innerWidth).Render(title))
+			continue
+		}
+		lines = append(lines, title+strings.Repeat(" ", gap)+detail)
+	}
+
+	box := lipgloss.NewStyle().
+		Border(o.properties.border).
+		BorderForeground(o.properties.borderColor).
+		Padding(0, 1).
+		Width(boxWidth - 2).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Top, "\n"+box)
+}
@@ -0,0 +1,163 @@
+package skeleton
+
+import (
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TabState is hold the state of a tab while it is rendered.
+type TabState struct {
+	// Active is true for the tab of the current page
+	Active bool
+
+	// Focused is true for the active tab while the tab bar has the focus
+	Focused bool
+
+	// Disabled is true for the inactive tabs while the tabs are locked
+	Disabled bool
+
+	// Badge is the badge of the tab, it is the zero Badge if the tab has none.
+	// The default renderer shows it after the title, custom renderers draw it themselves.
+	Badge Badge
+
+	// Accent is the accent color of the page or the color of its tab group, it is nil if there is none
+	Accent lipgloss.TerminalColor
+
+	// Group is the name of the tab group of the page, it is empty if the page has no group
+	Group string
+
+	// Floating is true for the wrapped tabs on the rows that do not touch the frame line,
+	// their borders should not join the frame line
+	Floating bool
+}
+
+// TabRenderer renders the tabs of the tab bar, e.g. powerline or underlined tabs.
+// The title already contains the tab index and the close glyph if they are enabled.
+// A tab may be one to three rows high, shorter tabs are centered on the frame line.
+// The Skeleton measures the rendered tabs and scrolls them when they do not fit.
+type TabRenderer interface {
+	RenderTab(title string, state TabState, index int) string
+}
+
+// WidgetState is hold the state of a widget while it is rendered.
+type WidgetState struct {
+	// Focused is true for the selected widget while the status bar has the focus
+	Focused bool
+
+	// Alert is true for the widgets reporting an error
+	Alert bool
+
+	// Indicator is true for the transient status shown before the widgets, e.g. the pending key sequence.
+	// Its index is -1.
+	Indicator bool
+
+	// Header is true for the widgets on the right side of the header, their index is the index among them
+	Header bool
+}
+
+// StatusBarRenderer renders the widgets of the status bar and the widgets on the right side of the header.
+// A widget may be one to three rows high, shorter widgets are centered on the frame line.
+// The Skeleton measures the rendered widgets and scrolls them when they do not fit.
+type StatusBarRenderer interface {
+	RenderWidget(value string, state WidgetState, index int) string
+}
+
+// defaultTabRenderer renders the tabs with the tab styles of the header, it is used if no renderer is set.
+type defaultTabRenderer struct {
+	header *header
+}
+
+// RenderTab renders the tab with the active, inactive or disabled tab style.
+func (r defaultTabRenderer) RenderTab(title string, state TabState, _ int) string {
+	return r.header.tabStyle(state).Render(r.header.badgedTitle(title, state))
+}
+
+// defaultStatusBarRenderer renders the widgets with the widget style, it is used if no renderer is set.
+type defaultStatusBarRenderer struct {
+	widget *widget
+}
+
+// RenderWidget renders the widget with the widget style, the alerts with the error color and the indicator with the accent color.
+func (r defaultStatusBarRenderer) RenderWidget(value string, state WidgetState, _ int) string {
+	return r.widget.renderStyle(state).Render(value)
+}
+
+// SetTabRenderer sets the renderer of the tabs, nil restores the default one.
+func (s *Skeleton) SetTabRenderer(renderer TabRenderer) *Skeleton {
+	s.header.SetTabRenderer(renderer)
+	s.triggerUpdate()
+	return s
+}
+
+// GetTabRenderer returns the renderer of the tabs, it is the default one if no renderer is set.
+// Custom renderers may wrap it to decorate the default tabs.
+func (s *Skeleton) GetTabRenderer() TabRenderer {
+	return s.header.GetTabRenderer()
+}
+
+// SetStatusBarRenderer sets the renderer of the widgets, nil restores the default one.
+func (s *Skeleton) SetStatusBarRenderer(renderer StatusBarRenderer) *Skeleton {
+	s.widget.SetStatusBarRenderer(renderer)
+	s.header.calculateTitleLength() // the widgets of the header are rendered with it as well
+	s.triggerUpdate()
+	return s
+}
+
+// GetStatusBarRenderer returns the renderer of the widgets, it is the default one if no renderer is set.
+func (s *Skeleton) GetStatusBarRenderer() StatusBarRenderer {
+	return s.widget.GetStatusBarRenderer()
+}
+
+// fitWindow returns the range [start, end) of the items fit into the available width around the anchor item.
+// The range starts from the offset if possible, so the items do not jump while the anchor moves inside it.
+// overflow returns the width of the indicators of the hidden items before and after the range.
+// ok is false if even the anchor item does not fit, an empty list always fits with an empty range.
+func fitWindow(widths []int, anchor, offset, available int, overflow func(before, after int) int) (start, end int, ok bool) {
+	count := len(widths)
+	if count == 0 {
+		return 0, 0, true
+	}
+	width := func(start, end int) int {
+		total := overflow(start, count-end)
+		for _, w := range widths[start:end] {
+			total += w
+		}
+		return total
+	}
+
+	if width(0, count) <= available {
+		return 0, count, true
+	}
+
+	anchor = max(min(anchor, count-1), 0)
+	start = max(min(offset, anchor), 0)
+	for start < anchor && width(start, anchor+1) > available {
+		start++
+	}
+	if width(start, anchor+1) > available {
+		return anchor, anchor + 1, false
+	}
+
+	end = anchor + 1
+	for end < count && width(start, end+1) <= available {
+		end++
+	}
+	for start > 0 && width(start-1, end) <= available {
+		start--
+	}
+	return start, end, true
+}
+
+// overflowText returns the indicator of the hidden items, e.g. "‹ 2 more" before and "3 more ›" after the visible items.
+// The marker is put next to the arrow, e.g. the badge of the hidden tabs.
+func overflowText(count int, before bool, marker string, g glyphs) string {
+	if before {
+		return fmt.Sprintf(" %s%s %d more ", marker, g.scrollLeft, count)
+	}
+	return fmt.Sprintf(" %d more %s%s ", count, g.scrollRight, marker)
+}
+
+// placeOnFrame returns the rendered tab or widget with the height of the bar, shorter ones are centered on the frame line.
+func placeOnFrame(rendered string) string {
+	return lipgloss.PlaceVertical(headerHeight, lipgloss.Center, rendered)
+}
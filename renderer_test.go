@@ -0,0 +1,112 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+// bracketRenderer renders the active tab and the widgets in brackets on a single row.
+type bracketRenderer struct{}
+
+func (bracketRenderer) RenderTab(title string, state TabState, _ int) string {
+	if state.Active {
+		return "[" + title + "]"
+	}
+	return " " + title + " "
+}
+
+func (bracketRenderer) RenderWidget(value string, _ WidgetState, _ int) string {
+	return "<" + value + ">"
+}
+
+func TestFitWindow(t *testing.T) {
+	indicators := func(before, after int) int {
+		return min(before, 1)*2 + min(after, 1)*2
+	}
+	none := func(before, after int) int { return 0 }
+
+	tests := []struct {
+		name      string
+		widths    []int
+		anchor    int
+		offset    int
+		available int
+		overflow  func(before, after int) int
+		start     int
+		end       int
+		ok        bool
+	}{
+		{"empty", nil, 0, 0, 10, indicators, 0, 0, true},
+		{"empty with negative width", nil, 0, 0, -5, indicators, 0, 0, true},
+		{"empty with anchor out of range", []int{}, 3, 2, 0, indicators, 0, 0, true},
+		{"all fit", []int{3, 3, 3}, 1, 0, 9, indicators, 0, 3, true},
+		{"all fit from offset", []int{3, 3, 3}, 2, 1, 20, indicators, 0, 3, true},
+		{"anchor too wide", []int{3, 12, 3}, 1, 0, 10, indicators, 1, 2, false},
+		{"negative width", []int{3, 3}, 1, 0, -1, indicators, 1, 2, false},
+		{"zero width", []int{1}, 0, 0, 0, none, 0, 1, false},
+		{"window after the anchor", []int{3, 3, 3, 3, 3}, 0, 0, 8, indicators, 0, 2, true},
+		{"window before the anchor", []int{3, 3, 3, 3, 3}, 4, 0, 8, indicators, 3, 5, true},
+		{"window keeps the offset", []int{3, 3, 3, 3, 3}, 2, 1, 10, indicators, 1, 3, true},
+		{"offset past the anchor", []int{3, 3, 3, 3, 3}, 1, 4, 10, indicators, 1, 3, true},
+		{"anchor out of range", []int{3, 3, 3, 3, 3}, 9, 0, 8, indicators, 3, 5, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := fitWindow(tt.widths, tt.anchor, tt.offset, tt.available, tt.overflow)
+			if start != tt.start || end != tt.end || ok != tt.ok {
+				t.Errorf("fitWindow() = %d, %d, %v, want %d, %d, %v", start, end, ok, tt.start, tt.end, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCustomRenderers(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second")
+	s.SetTabRenderer(bracketRenderer{}).SetStatusBarRenderer(bracketRenderer{})
+	s.Update(AddNewWidget{Key: "time", Value: "12:00"})
+	s.Update(tea.WindowSizeMsg{Width: 60, Height: 10})
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlRight})
+	settle(s)
+
+	view := ansi.Strip(s.View())
+	for _, want := range []string{" first ", "[second]", "<12:00>"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view has no %q:\n%s", want, view)
+		}
+	}
+
+	s.SetTabRenderer(nil)
+	if view := ansi.Strip(s.View()); strings.Contains(view, "[second]") {
+		t.Errorf("view is rendered by the custom tab renderer after it is removed:\n%s", view)
+	}
+}
+
+func TestTabOverflowKeepsTheActiveTabVisible(t *testing.T) {
+	var keys []string
+	for i := 0; i < 12; i++ {
+		keys = append(keys, fmt.Sprintf("page-%d", i))
+	}
+	s, _ := newTestSkeleton(keys...)
+	s.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	settle(s)
+	view := ansi.Strip(s.View())
+	if !strings.Contains(view, "page-11") || strings.Contains(view, "page-0 ") {
+		t.Errorf("view does not scroll to the last tab:\n%s", view)
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if ansi.StringWidth(line) > 40 {
+			t.Errorf("line %q is wider than the terminal", line)
+		}
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlHome})
+	if view := ansi.Strip(s.View()); !strings.Contains(view, "page-0") || strings.Contains(view, "page-11") {
+		t.Errorf("view does not scroll back to the first tab:\n%s", view)
+	}
+}
@@ -0,0 +1,250 @@
+package skeleton
+
+import (
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+)
+
+// defaultSidebarWidth is the default width of the tab list on the sidebar.
+const defaultSidebarWidth = 24
+
+// SetSidebar sets the tabs are listed vertically on a sidebar on the left of the pages instead of the tab bar or not.
+// It is useful with many pages, the tabs keep their styles, badges and locking, and scroll when they do not fit.
+// The header keeps the application title and the header widgets, it is a single frame line without them.
+func (s *Skeleton) SetSidebar(enabled bool) *Skeleton {
+	s.header.SetSidebar(enabled)
+	s.triggerUpdate()
+	return s
+}
+
+// IsSidebar returns the tabs are listed on the sidebar or not.
+func (s *Skeleton) IsSidebar() bool {
+	return s.header.IsSidebar()
+}
+
+// SetSidebarWidth sets the width of the tab list on the sidebar, the longer titles are truncated.
+func (s *Skeleton) SetSidebarWidth(width int) *Skeleton {
+	s.header.SetSidebarWidth(width)
+	s.triggerUpdate()
+	return s
+}
+
+// GetSidebarWidth returns the width of the tab list on the sidebar.
+func (s *Skeleton) GetSidebarWidth() int {
+	return s.header.GetSidebarWidth()
+}
+
+// SetSidebarCollapsed sets the sidebar shows only the icons of the tabs instead of the full titles or not.
+// The tabs without an icon show the first letter of their titles.
+func (s *Skeleton) SetSidebarCollapsed(collapsed bool) *Skeleton {
+	s.header.SetSidebarCollapsed(collapsed)
+	s.triggerUpdate()
+	return s
+}
+
+// IsSidebarCollapsed returns the sidebar shows only the icons of the tabs or not.
+func (s *Skeleton) IsSidebarCollapsed() bool {
+	return s.header.IsSidebarCollapsed()
+}
+
+// sidebarWidth returns the width the sidebar takes from the pages with its separator, it is 0 if the tabs are on the tab bar.
+func (s *Skeleton) sidebarWidth() int {
+	if !s.header.IsSidebar() {
+		return 0
+	}
+	return s.header.sidebarColumnWidth() + 1
+}
+
+// SetSidebar sets the tabs are listed on the sidebar or not.
+func (h *header) SetSidebar(enabled bool) {
+	h.properties.sidebar = enabled
+	h.calculateTitleLength()
+}
+
+// IsSidebar returns the tabs are listed on the sidebar or not.
+func (h *header) IsSidebar() bool {
+	return h.properties.sidebar
+}
+
+// SetSidebarWidth sets the width of the tab list on the sidebar.
+func (h *header) SetSidebarWidth(width int) {
+	h.properties.sidebarWidth = max(width, 1)
+}
+
+// GetSidebarWidth returns the width of the tab list on the sidebar.
+func (h *header) GetSidebarWidth() int {
+	return h.properties.sidebarWidth
+}
+
+// SetSidebarCollapsed sets the sidebar shows only the icons of the tabs or not.
+func (h *header) SetSidebarCollapsed(collapsed bool) {
+	h.properties.sidebarCollapsed = collapsed
+}
+
+// IsSidebarCollapsed returns the sidebar shows only the icons of the tabs or not.
+func (h *header) IsSidebarCollapsed() bool {
+	return h.properties.sidebarCollapsed
+}
+
+// sidebarColumnWidth returns the width of the tab list on the sidebar, it fits the widest tab while collapsed.
+func (h *header) sidebarColumnWidth() int {
+	if !h.properties.sidebarCollapsed {
+		return h.properties.sidebarWidth
+	}
+
+	var width int
+	for i := range h.headers {
+		text, badge := h.sidebarEntry(i)
+		width = max(width, lipgloss.Width(text)+lipgloss.Width(badge))
+	}
+	return width + 1 // for the space before the separator
+}
+
+// sidebarEntry returns the text of the tab on the sidebar and its badge, the active tab is marked with the pointer.
+// The collapsed sidebar shows the icon (or the spinner) of the tab and the badge glyph instead of the badge text.
+func (h *header) sidebarEntry(index int) (text, badge string) {
+	hdr := h.headers[index]
+	marker := " "
+	if index == h.currentTab {
+		marker = h.properties.glyphs.pointer
+	}
+
+	if h.properties.sidebarCollapsed {
+		glyph := h.iconGlyph(hdr)
+		switch {
+		case hdr.busy:
+			glyph = h.spinner()
+		case glyph == "":
+			glyph = strings.ToUpper(string([]rune(hdr.title + " ")[0]))
+		}
+		if hdr.badge.State != 0 {
+			badge = " " + h.properties.glyphs.badge
+		}
+		return marker + " " + glyph, badge
+	}
+
+	// the tabs on the sidebar are closed by the middle click, they have no close glyph
+	title := h.displayTitle(index, hdr)
+	if h.hasCloseButton(hdr) {
+		title = strings.TrimSuffix(title, " "+h.properties.glyphs.close)
+	}
+
+	if hdr.badge.State != 0 {
+		badge = " " + hdr.badge.Text
+		if hdr.badge.Text == "" {
+			badge = " " + h.properties.glyphs.badge
+		}
+	}
+	return marker + " " + title, badge
+}
+
+// renderSidebarTab renders the tab by the given index on a row of the sidebar with the text style of its tab style.
+// The title is truncated to keep the badge visible.
+func (h *header) renderSidebarTab(index, width int) string {
+	state := h.tabState(index)
+	base := textStyle(h.tabStyle(state))
+	text, badge := h.sidebarEntry(index)
+
+	available := width - 1 // for the space before the separator
+	badge = ansi.Truncate(badge, available, "")
+	if lipgloss.Width(text)+lipgloss.Width(badge) > available {
+		text = ansi.Truncate(text, available-lipgloss.Width(badge), h.properties.glyphs.ellipsis)
+	}
+
+	rendered := base.Render(text)
+	if badge != "" {
+		rendered += h.badgeStyle(state.Badge.State, base).Render(badge)
+	}
+	fill := width - lipgloss.Width(text) - lipgloss.Width(badge)
+	return rendered + base.Render(strings.Repeat(" ", max(fill, 0)))
+}
+
+// renderSidebarOverflow renders the indicator of the given count of the hidden tabs above or below the visible ones.
+// The collapsed sidebar shows the arrow and the count only, e.g. "‹3".
+func (h *header) renderSidebarOverflow(count int, before bool, width int) string {
+	style := lipgloss.NewStyle().
+		Foreground(h.properties.titleStyleInactive.GetForeground()).
+		Renderer(chromeRenderer(h.properties.monochrome))
+
+	text := strings.TrimSpace(overflowText(count, before, "", h.properties.glyphs))
+	if lipgloss.Width(text) > width {
+		text = fmt.Sprintf("%d%s", count, h.properties.glyphs.scrollRight)
+		if before {
+			text = fmt.Sprintf("%s%d", h.properties.glyphs.scrollLeft, count)
+		}
+	}
+	text = ansi.Truncate(text, width, "")
+	return style.Render(text + strings.Repeat(" ", width-lipgloss.Width(text)))
+}
+
+// setSidebarHeight sets the height of the sidebar and calculates its visible tabs.
+func (h *header) setSidebarHeight(height int) {
+	if height == h.sidebarHeight {
+		return
+	}
+	h.sidebarHeight = height
+	h.layoutSidebar()
+}
+
+// layoutSidebar calculates the visible tabs on the sidebar around the current tab.
+func (h *header) layoutSidebar() {
+	rows := make([]int, len(h.headers))
+	for i := range rows {
+		rows[i] = 1
+	}
+	indicators := func(before, after int) int {
+		return min(before, 1) + min(after, 1)
+	}
+	h.sidebarOffset, h.sidebarEnd, _ = fitWindow(rows, h.currentTab, h.sidebarOffset, h.sidebarHeight, indicators)
+}
+
+// SidebarView renders the sidebar of the given height with the left side of the frame and the separator on its right.
+// The visible tabs are calculated by the update, see setSidebarHeight.
+func (h *header) SidebarView(height int) string {
+	width := h.sidebarColumnWidth()
+
+	var rows []string
+	if h.sidebarOffset > 0 {
+		rows = append(rows, h.renderSidebarOverflow(h.sidebarOffset, true, width))
+	}
+	for i := h.sidebarOffset; i < h.sidebarEnd; i++ {
+		rows = append(rows, h.renderSidebarTab(i, width))
+	}
+	if h.sidebarEnd < len(h.headers) {
+		rows = append(rows, h.renderSidebarOverflow(len(h.headers)-h.sidebarEnd, false, width))
+	}
+	for len(rows) < height {
+		rows = append(rows, strings.Repeat(" ", width))
+	}
+	rows = rows[:max(height, 0)]
+
+	frame := h.properties.borderSet.Frame
+	border := lipgloss.NewStyle().Foreground(h.frameColor())
+	left := border.Render(strings.TrimSuffix(strings.Repeat(frame.Left+"\n", height), "\n"))
+	separator := border.Render(strings.TrimSuffix(strings.Repeat(frame.Right+"\n", height), "\n"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, strings.Join(rows, "\n"), separator)
+}
+
+// SidebarTabAt returns the index of the tab at the given row of the sidebar, the row is relative to the sidebar.
+// The indicators of the hidden tabs return the nearest hidden tab.
+func (h *header) SidebarTabAt(row int) (int, bool) {
+	if !h.properties.sidebar || row < 0 {
+		return 0, false
+	}
+
+	if h.sidebarOffset > 0 {
+		if row == 0 {
+			return h.sidebarOffset - 1, true
+		}
+		row--
+	}
+	if index := h.sidebarOffset + row; index < h.sidebarEnd {
+		return index, true
+	}
+	if row == h.sidebarEnd-h.sidebarOffset && h.sidebarEnd < len(h.headers) {
+		return h.sidebarEnd, true
+	}
+	return 0, false
+}
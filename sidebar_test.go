@@ -0,0 +1,29 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+)
+
+func TestSidebarLayoutWithoutView(t *testing.T) {
+	var keys []string
+	for i := 0; i < 20; i++ {
+		keys = append(keys, fmt.Sprintf("page-%d", i))
+	}
+	s, _ := newTestSkeleton(keys...)
+	s.SetSidebar(true)
+	s.Update(tea.WindowSizeMsg{Width: 60, Height: 12})
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+
+	height := s.bodyHeight()
+	if s.header.sidebarEnd != 20 || s.header.sidebarOffset != 20-(height-1) {
+		t.Fatalf("visible tabs = [%d, %d), want the last %d tabs below the indicator", s.header.sidebarOffset, s.header.sidebarEnd, height-1)
+	}
+	if index, ok := s.header.SidebarTabAt(height - 1); !ok || index != 19 {
+		t.Errorf("tab at the last row = %d, %v, want the current tab", index, ok)
+	}
+	if index, ok := s.header.SidebarTabAt(0); !ok || index != s.header.sidebarOffset-1 {
+		t.Errorf("tab at the indicator = %d, %v, want the nearest hidden tab", index, ok)
+	}
+}
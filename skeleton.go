@@ -5,6 +5,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"io"
 	"strings"
 )
 
@@ -34,9 +35,36 @@ type Skeleton struct {
 	// widget is hold the widget
 	widget *widget
 
+	// overlay is hold the overlay, it is shown on top of the active page
+	overlay *overlay
+
+	// keyHelp is hold the key binding help, it is shown on top of the active page or above the widgets
+	keyHelp *keyHelp
+
+	// focus is hold the area that receives the key presses
+	focus Focus
+
+	// busyTicking is control the tick of the tab spinners is running or not
+	busyTicking bool
+
+	// zoomed is control the active page uses the whole terminal without the header, the widgets and the borders
+	zoomed bool
+
+	// monitorTicking is control the tick of the page monitoring is running or not
+	monitorTicking bool
+
+	// contentSizeSent is hold the size of the page content sent to the pages by the last ContentSizeMsg
+	contentSizeSent ContentSizeMsg
+
+	// focusKeyMap responsible for the key bindings used while the tab bar or the status bar is focused
+	focusKeyMap *focusKeyMap
+
 	// KeyMap responsible for the key bindings
 	KeyMap *keyMap
 
+	// commands are hold the registered commands, they are listed on the command palette
+	commands []*command
+
 	// pages are hold the pages
 	pages []tea.Model
 
@@ -49,27 +77,49 @@ type Skeleton struct {
 
 // NewSkeleton returns a new Skeleton.
 func NewSkeleton() *Skeleton {
-	return &Skeleton{
-		properties: defaultSkeletonProperties(),
-		viewport:   newTerminalViewport(),
-		header:     newHeader(),
-		widget:     newWidget(),
-		KeyMap:     newKeyMap(),
-		updateChan: make(chan any),
+	s := &Skeleton{
+		properties:  defaultSkeletonProperties(),
+		viewport:    newTerminalViewport(),
+		header:      newHeader(),
+		widget:      newWidget(),
+		overlay:     newOverlay(),
+		keyHelp:     newKeyHelp(),
+		focusKeyMap: newFocusKeyMap(),
+		KeyMap:      newKeyMap(),
+		updateChan:  make(chan any),
 	}
+	s.header.statusBar = s.widget
+
+	// the background is queried once here, before the program owns the terminal
+	s.properties.darkBackground = lipgloss.HasDarkBackground()
+	s.widget.setDarkBackground(s.properties.darkBackground)
+	if s.properties.monochrome {
+		s.applyTheme()
+	}
+	return s
 }
 
 // skeletonProperties are hold the properties of the Skeleton.
 type skeletonProperties struct {
-	borderColor  string
-	pagePosition lipgloss.Position
+	theme          Theme
+	monochrome     bool
+	borderSet      BorderSet
+	asciiOnly      bool
+	iconMode       IconMode
+	monitorAlert   MonitorAlert
+	alertOutput    io.Writer
+	frameStyle     lipgloss.Style
+	darkBackground bool // darkBackground is resolved by NewSkeleton for the getters of the adaptive colors
 }
 
 // defaultSkeletonProperties returns the default properties of the Skeleton.
 func defaultSkeletonProperties() *skeletonProperties {
 	return &skeletonProperties{
-		borderColor:  "39",
-		pagePosition: lipgloss.Center,
+		theme:      DefaultTheme(),
+		monochrome: isColorless(),
+		borderSet:  RoundedBorderSet(),
+		iconMode:   defaultIconMode(),
+		frameStyle: lipgloss.NewStyle().Align(lipgloss.Center),
 	}
 }
 
@@ -95,16 +145,20 @@ func (s *Skeleton) triggerUpdate() {
 
 // SetBorderColor sets the border color of the Skeleton.
 func (s *Skeleton) SetBorderColor(color string) *Skeleton {
+	s.properties.theme.Border = lipgloss.Color(color)
 	s.header.SetBorderColor(color)
 	s.widget.SetBorderColor(color)
-	s.properties.borderColor = color
+	if !s.properties.monochrome {
+		s.overlay.SetBorderColor(color)
+		s.keyHelp.SetBorderColor(color)
+	}
 	s.triggerUpdate()
 	return s
 }
 
 // GetBorderColor returns the border color of the Skeleton.
 func (s *Skeleton) GetBorderColor() string {
-	return s.properties.borderColor
+	return colorString(s.properties.theme.Border, s.properties.darkBackground)
 }
 
 // GetWidgetBorderColor returns the border color of the Widget.
@@ -112,20 +166,21 @@ func (s *Skeleton) GetWidgetBorderColor() string {
 	return s.widget.GetBorderColor()
 }
 
-// SetPagePosition sets the position of the page.
+// SetPagePosition sets the position of the page, it is the horizontal alignment of the frame style.
 func (s *Skeleton) SetPagePosition(position lipgloss.Position) *Skeleton {
-	s.properties.pagePosition = position
+	s.properties.frameStyle = s.properties.frameStyle.Align(position)
 	s.triggerUpdate()
 	return s
 }
 
 // GetPagePosition returns the position of the page.
 func (s *Skeleton) GetPagePosition() lipgloss.Position {
-	return s.properties.pagePosition
+	return s.properties.frameStyle.GetAlignHorizontal()
 }
 
 // SetInactiveTabTextColor sets the idle tab color of the Skeleton.
 func (s *Skeleton) SetInactiveTabTextColor(color string) *Skeleton {
+	s.properties.theme.InactiveTabText = lipgloss.Color(color)
 	s.header.SetInactiveTabTextColor(color)
 	s.triggerUpdate()
 	return s
@@ -133,6 +188,7 @@ func (s *Skeleton) SetInactiveTabTextColor(color string) *Skeleton {
 
 // SetInactiveTabBorderColor sets the idle tab border color of the Skeleton.
 func (s *Skeleton) SetInactiveTabBorderColor(color string) *Skeleton {
+	s.properties.theme.InactiveTabBorder = lipgloss.Color(color)
 	s.header.SetInactiveTabBorderColor(color)
 	s.triggerUpdate()
 	return s
@@ -140,6 +196,7 @@ func (s *Skeleton) SetInactiveTabBorderColor(color string) *Skeleton {
 
 // SetActiveTabTextColor sets the active tab color of the Skeleton.
 func (s *Skeleton) SetActiveTabTextColor(color string) *Skeleton {
+	s.properties.theme.ActiveTabText = lipgloss.Color(color)
 	s.header.SetActiveTabTextColor(color)
 	s.triggerUpdate()
 	return s
@@ -147,13 +204,28 @@ func (s *Skeleton) SetActiveTabTextColor(color string) *Skeleton {
 
 // SetActiveTabBorderColor sets the active tab border color of the Skeleton.
 func (s *Skeleton) SetActiveTabBorderColor(color string) *Skeleton {
+	s.properties.theme.ActiveTabBorder = lipgloss.Color(color)
 	s.header.SetActiveTabBorderColor(color)
 	s.triggerUpdate()
 	return s
 }
 
+// ShowTabIndex sets the tab index prefix (1, 2, 3…) is rendered on the tab titles or not.
+// It is useful with the jump to tab key bindings.
+func (s *Skeleton) ShowTabIndex(show bool) *Skeleton {
+	s.header.SetShowTabIndex(show)
+	s.triggerUpdate()
+	return s
+}
+
+// IsTabIndexShown returns the tab index prefix is rendered on the tab titles or not.
+func (s *Skeleton) IsTabIndexShown() bool {
+	return s.header.GetShowTabIndex()
+}
+
 // SetWidgetBorderColor sets the border color of the Widget.
 func (s *Skeleton) SetWidgetBorderColor(color string) *Skeleton {
+	s.properties.theme.WidgetBorder = lipgloss.Color(color)
 	s.widget.SetWidgetBorderColor(color)
 	s.triggerUpdate()
 	return s
@@ -176,6 +248,7 @@ func (s *Skeleton) SetTabRightPadding(padding int) *Skeleton {
 // SetWidgetLeftPadding sets the left padding of the Skeleton.
 func (s *Skeleton) SetWidgetLeftPadding(padding int) *Skeleton {
 	s.widget.SetLeftPadding(padding)
+	s.header.calculateTitleLength()
 	s.triggerUpdate()
 	return s
 }
@@ -183,6 +256,7 @@ func (s *Skeleton) SetWidgetLeftPadding(padding int) *Skeleton {
 // SetWidgetRightPadding sets the right padding of the Skeleton.
 func (s *Skeleton) SetWidgetRightPadding(padding int) *Skeleton {
 	s.widget.SetRightPadding(padding)
+	s.header.calculateTitleLength()
 	s.triggerUpdate()
 	return s
 }
@@ -208,6 +282,18 @@ func (s *Skeleton) IsTabsLocked() bool {
 	return s.lockTabs
 }
 
+// SetTabNavigationWrap sets the tab navigation cycles from the last tab to the first one (and vice versa) or not.
+func (s *Skeleton) SetTabNavigationWrap(wrap bool) *Skeleton {
+	s.header.SetWrapNavigation(wrap)
+	s.triggerUpdate()
+	return s
+}
+
+// IsTabNavigationWrap returns the tab navigation cycles from the last tab to the first one or not.
+func (s *Skeleton) IsTabNavigationWrap() bool {
+	return s.header.GetWrapNavigation()
+}
+
 // AddPage adds a new page to the Skeleton.
 type AddPage struct {
 	// Key is unique key of the page, it is used to identify the page
@@ -220,8 +306,8 @@ type AddPage struct {
 	Page tea.Model
 }
 
-// AddPage adds a new page to the Skeleton.
-func (s *Skeleton) AddPage(key string, title string, page tea.Model) *Skeleton {
+// AddPage adds a new page to the Skeleton, the options set e.g. the icon of its tab.
+func (s *Skeleton) AddPage(key string, title string, page tea.Model, options ...PageOption) *Skeleton {
 	// do not add if key already exists
 	for _, hdr := range s.header.headers {
 		if hdr.key == key {
@@ -229,7 +315,7 @@ func (s *Skeleton) AddPage(key string, title string, page tea.Model) *Skeleton {
 		}
 	}
 
-	s.header.AddCommonHeader(key, title)
+	s.header.AddCommonHeader(key, title, options...)
 	s.pages = append(s.pages, page)
 	go func() {
 		s.updateChan <- AddPage{
@@ -294,6 +380,10 @@ func (s *Skeleton) deletePage(key string) {
 	if s.GetActivePage() == key {
 		s.currentTab = 0
 		s.header.SetCurrentTab(0)
+	} else if s.pageIndex(key) >= 0 && s.pageIndex(key) < s.currentTab {
+		// keep the active page, its index is shifted by the deleted page
+		s.currentTab--
+		s.header.SetCurrentTab(s.currentTab)
 	}
 
 	var pages []tea.Model
@@ -303,9 +393,62 @@ func (s *Skeleton) deletePage(key string) {
 		}
 	}
 	s.header.DeleteCommonHeader(key)
+	s.deletePageCommands(key)
 	s.pages = pages
 }
 
+// SetPagePinned sets the page by the given key is pinned or not.
+// Pinned pages can not be closed by the close tab key binding or the command palette, DeletePage still deletes them.
+func (s *Skeleton) SetPagePinned(key string, pinned bool) *Skeleton {
+	s.header.SetPinned(key, pinned)
+	s.triggerUpdate()
+	return s
+}
+
+// IsPagePinned returns the page by the given key is pinned or not.
+func (s *Skeleton) IsPagePinned(key string) bool {
+	return s.header.IsPinned(key)
+}
+
+// canCloseActivePage returns the active page can be closed by the user or not.
+func (s *Skeleton) canCloseActivePage() bool {
+	return s.canClosePage(s.GetActivePage())
+}
+
+// canClosePage returns the page by the given key can be closed by the user or not.
+func (s *Skeleton) canClosePage(key string) bool {
+	return !s.IsTabsLocked() && len(s.pages) > 1 && s.pageIndex(key) >= 0 && !s.IsPagePinned(key)
+}
+
+// closeActivePage closes the active page if it is not pinned and the tabs are not locked.
+func (s *Skeleton) closeActivePage(cmds []tea.Cmd) []tea.Cmd {
+	return s.closePage(cmds, s.GetActivePage())
+}
+
+// closePage closes the page by the given key if it is not pinned and the tabs are not locked.
+func (s *Skeleton) closePage(cmds []tea.Cmd, key string) []tea.Cmd {
+	if !s.canClosePage(key) {
+		return cmds
+	}
+
+	active := s.GetActivePage() == key
+	s.deletePage(key)
+	if active {
+		cmds = append(cmds, s.IAMActivePageCmd())
+	}
+	return cmds
+}
+
+// pageIndex returns the index of the page by the given key, it is -1 if the page does not exist.
+func (s *Skeleton) pageIndex(key string) int {
+	for i, hdr := range s.header.headers {
+		if hdr.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
 // AddWidget adds a new widget to the Skeleton.
 func (s *Skeleton) AddWidget(key string, value string) *Skeleton {
 	go func() {
@@ -380,23 +523,57 @@ func (s *Skeleton) IAMActivePageCmd() tea.Cmd {
 	}
 }
 
+// switchPage switches to the previous or the next page, header is the source of truth of the current tab.
 func (s *Skeleton) switchPage(cmds []tea.Cmd, position string) []tea.Cmd {
-	switch position {
-	case "left":
-		if !s.IsTabsLocked() {
-			s.currentTab = max(s.currentTab-1, 0)
-			cmds = append(cmds, s.IAMActivePageCmd())
-		}
-	case "right":
-		if !s.IsTabsLocked() {
-			s.currentTab = min(s.currentTab+1, len(s.pages)-1)
-			cmds = append(cmds, s.IAMActivePageCmd())
-		}
+	step := 1
+	if position == "left" {
+		step = -1
+	}
+
+	if s.header.MoveTab(step) {
+		s.currentTab = s.header.GetCurrentTab()
+		cmds = append(cmds, s.IAMActivePageCmd())
+	}
+
+	return cmds
+}
+
+// jumpToPage switches to the page at the given index.
+func (s *Skeleton) jumpToPage(cmds []tea.Cmd, index int) []tea.Cmd {
+	if s.header.SelectTab(index) {
+		s.currentTab = s.header.GetCurrentTab()
+		cmds = append(cmds, s.IAMActivePageCmd())
 	}
 
 	return cmds
 }
 
+// OpenTabSearch opens the tab search overlay, it filters the tabs by their titles and keys.
+// The selected tab becomes the active page. It does nothing while the tabs are locked.
+func (s *Skeleton) OpenTabSearch() *Skeleton {
+	if s.IsTabsLocked() {
+		return s
+	}
+
+	items := make([]overlayItem, len(s.header.headers))
+	for i, hdr := range s.header.headers {
+		key := hdr.key
+		items[i] = overlayItem{
+			title:  hdr.title,
+			detail: key,
+			run: func() tea.Cmd {
+				s.SetActivePage(key)
+				return s.IAMActivePageCmd()
+			},
+		}
+	}
+
+	s.keyHelp.Close()
+	s.overlay.Open("Go to tab", items)
+	s.triggerUpdate()
+	return s
+}
+
 func (s *Skeleton) updateSkeleton(msg tea.Msg, cmd tea.Cmd, cmds []tea.Cmd) []tea.Cmd {
 	s.header, cmd = s.header.Update(msg)
 	cmds = append(cmds, cmd)
@@ -411,6 +588,148 @@ func (s *Skeleton) updateSkeleton(msg tea.Msg, cmd tea.Cmd, cmds []tea.Cmd) []te
 	return cmds
 }
 
+// updateKey handles the key press, it resolves the key sequences before dispatching the keys.
+func (s *Skeleton) updateKey(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	if key.Matches(msg, s.KeyMap.Quit) {
+		return tea.Quit
+	}
+	if s.overlay.IsActive() {
+		// the overlay takes the keys until it is closed
+		s.overlay, cmd = s.overlay.Update(msg)
+		return cmd
+	}
+	if s.keyHelp.IsActive() {
+		// any of the help or the escape keys closes the help overlay
+		if key.Matches(msg, s.KeyMap.Help) || msg.Type == tea.KeyEsc {
+			s.keyHelp.Close()
+		}
+		return nil
+	}
+	if s.pageClaimsInput() {
+		// global key bindings are suspended, the held keys of a key sequence are dropped
+		s.KeyMap.takeChord()
+		s.widget.SetIndicator("")
+		return tea.Batch(s.updateSkeleton(msg, cmd, nil)...)
+	}
+	if s.focus != FocusPage {
+		if cmd, ok := s.updateFocusKey(msg); ok {
+			return cmd
+		}
+	}
+
+	keys, state := s.KeyMap.feedChord(msg, s.chordSequences())
+	switch state {
+	case chordPending:
+		_, g := s.chromeBorderSet()
+		s.widget.SetIndicator(s.KeyMap.PendingChord() + " " + g.ellipsis)
+		return s.KeyMap.chordTimeoutCmd()
+	case chordMatched:
+		s.widget.SetIndicator("")
+		return tea.Batch(s.dispatchKey(keys, msg, false)...)
+	case chordBroken:
+		// the held keys are replayed, the key that broke the sequence may start another one
+		return tea.Batch(s.replayChord(), s.updateKey(msg))
+	}
+
+	return tea.Batch(s.dispatchKey(keys, msg, s.focus == FocusPage)...)
+}
+
+// replayChord dispatches the held keys of the incomplete key sequence as single key presses.
+// The keys are dispatched in order, their commands are batched since they include the listeners of the update channels.
+func (s *Skeleton) replayChord() tea.Cmd {
+	s.widget.SetIndicator("")
+
+	var cmds []tea.Cmd
+	for _, msg := range s.KeyMap.takeChord() {
+		cmds = append(cmds, s.dispatchKey(chordKey(msg), msg, s.focus == FocusPage)...)
+	}
+	return tea.Batch(cmds...)
+}
+
+// chordSequences returns the key sequences of the global key bindings and the active commands.
+func (s *Skeleton) chordSequences() []string {
+	var bindings []key.Binding
+	for _, action := range s.KeyMap.keyActions() {
+		bindings = append(bindings, *s.KeyMap.binding(action))
+	}
+	for _, c := range s.activeCommands() {
+		bindings = append(bindings, c.binding)
+	}
+
+	var sequences []string
+	for _, binding := range bindings {
+		if !binding.Enabled() {
+			continue
+		}
+		for _, keys := range binding.Keys() {
+			if isChord(keys) {
+				sequences = append(sequences, normalizeKeys(keys))
+			}
+		}
+	}
+	return sequences
+}
+
+// dispatchKey runs the key binding bound to the given key or key sequence.
+// The key press is forwarded to the active page if forward is true and the key is not taken by the Skeleton.
+func (s *Skeleton) dispatchKey(keys string, msg tea.KeyMsg, forward bool) []tea.Cmd {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	switch {
+	case s.KeyMap.matches(keys, s.KeyMap.Quit):
+		return []tea.Cmd{tea.Quit}
+	case s.KeyMap.matches(keys, s.KeyMap.SwitchTabLeft):
+		return s.switchPage(cmds, "left")
+	case s.KeyMap.matches(keys, s.KeyMap.SwitchTabRight):
+		return s.switchPage(cmds, "right")
+	case s.KeyMap.matches(keys, s.KeyMap.FirstTab):
+		return s.jumpToPage(cmds, 0)
+	case s.KeyMap.matches(keys, s.KeyMap.LastTab):
+		return s.jumpToPage(cmds, len(s.pages)-1)
+	case s.KeyMap.matches(keys, s.KeyMap.NextTabGroup):
+		if index, ok := s.header.NextGroupTab(); ok {
+			cmds = s.jumpToPage(cmds, index)
+		}
+		return cmds
+	case s.header.IsSidebar() && s.KeyMap.matches(keys, s.KeyMap.ToggleSidebar):
+		s.header.SetSidebarCollapsed(!s.header.IsSidebarCollapsed())
+		return cmds
+	case s.KeyMap.matches(keys, s.KeyMap.Zoom):
+		s.ToggleZoom()
+		return cmds
+	case s.KeyMap.matches(keys, s.KeyMap.CloseTab):
+		return s.closeActivePage(cmds)
+	case s.KeyMap.matches(keys, s.KeyMap.SearchTab):
+		s.OpenTabSearch()
+		return cmds
+	case s.KeyMap.matches(keys, s.KeyMap.OpenPalette):
+		s.OpenCommandPalette()
+		return cmds
+	case s.KeyMap.matches(keys, s.KeyMap.Help):
+		s.ToggleHelp()
+		return cmds
+	case s.KeyMap.matches(keys, s.KeyMap.SwitchFocus):
+		s.cycleFocus()
+		return cmds
+	}
+	if cmd, ok := s.runCommandBinding(keys); ok {
+		return append(cmds, cmd)
+	}
+	for i, binding := range s.KeyMap.JumpToTab {
+		if s.KeyMap.matches(keys, binding) {
+			return s.jumpToPage(cmds, i)
+		}
+	}
+
+	if !forward {
+		return cmds
+	}
+	return s.updateSkeleton(msg, cmd, cmds)
+}
+
 func (s *Skeleton) Init() tea.Cmd {
 	if len(s.pages) == 0 {
 		panic("skeleton: no pages added, please add at least one page")
@@ -437,21 +756,36 @@ func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		cmds = s.updateSkeleton(msg, cmd, cmds)
 	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, s.KeyMap.Quit):
-			return s, tea.Quit
-		case key.Matches(msg, s.KeyMap.SwitchTabLeft):
-			cmds = s.switchPage(cmds, "left")
-		case key.Matches(msg, s.KeyMap.SwitchTabRight):
-			cmds = s.switchPage(cmds, "right")
+		cmds = append(cmds, s.updateKey(msg))
+	case tea.MouseMsg:
+		cmds = s.updateMouse(msg, cmds)
+	case chordTimeoutMsg:
+		if s.KeyMap.isChordTimeout(msg) {
+			cmds = append(cmds, s.replayChord())
 		}
-		cmds = s.updateSkeleton(msg, cmd, cmds)
+	case busyTickMsg:
+		cmds = s.updateBusyTick(cmds)
+	case monitorTickMsg:
+		cmds = s.updateMonitorTick(cmds)
+	case themeFileChanged:
+		_ = s.LoadTheme(msg.path)
+		cmds = append(cmds, s.Listen())
 	case AddPage:
 		cmds = append(cmds, msg.Page.Init()) // init the page
+		s.contentSizeSent = ContentSizeMsg{} // the new page gets the size of the page content as well
 		cmds = s.updateSkeleton(msg, cmd, cmds)
 	case UpdatePageTitle:
 		s.updatePageTitle(msg.Key, msg.Title)
 		cmds = s.updateSkeleton(msg, cmd, cmds)
+	case UpdatePageBadge:
+		s.header.SetBadge(msg.Key, msg.Badge)
+		cmds = s.updateSkeleton(msg, cmd, cmds)
+	case UpdatePageBusy:
+		s.header.SetBusy(msg.Key, msg.Busy)
+		cmds = s.updateSkeleton(msg, cmd, cmds)
+	case UpdatePageProgress:
+		s.header.SetProgress(msg.Key, msg.Progress)
+		cmds = s.updateSkeleton(msg, cmd, cmds)
 	case DeletePage:
 		s.deletePage(msg.Key)
 		cmds = append(cmds, s.IAMActivePageCmd())
@@ -463,19 +797,61 @@ func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		s.termSizeNotEnoughToHandleHeaders = msg.NotEnoughToHandleHeaders
 	case WidgetSizeMsg:
 		s.termSizeNotEnoughToHandleWidgets = msg.NotEnoughToHandleWidgets
-	case AddNewWidget, UpdateWidgetContent, DeleteWidget:
+	case AddNewWidget, UpdateWidgetContent:
 		cmds = s.updateSkeleton(msg, cmd, cmds)
+	case DeleteWidget:
+		cmds = s.updateSkeleton(msg, cmd, cmds)
+		if s.focus == FocusStatusBar {
+			// keep the selection in range, the page takes the focus back if there is no widget left
+			s.SetFocus(FocusStatusBar)
+		}
 	default:
 		cmds = s.updateSkeleton(msg, cmd, cmds)
 	}
 
+	cmds = s.updateContentSize(cmds)
+	s.updateFrameAccent()
+	s.header.setSidebarHeight(s.bodyHeight())
+	cmds = s.scheduleBusyTick(cmds)
+	cmds = s.scheduleMonitorTick(cmds)
 	return s, tea.Batch(cmds...)
 }
 
+// bodyView renders the active page, or the help and the other overlays on top of it, with the height of the page content.
+func (s *Skeleton) bodyView() string {
+	contentWidth, contentHeight := s.contentSize()
+
+	var body string
+	switch {
+	case s.keyHelp.IsActive():
+		body = s.keyHelp.View(contentWidth, contentHeight, s.helpSections())
+	case s.overlay.IsActive():
+		body = s.overlay.View(contentWidth, contentHeight)
+	default:
+		body = s.pages[s.currentTab].View()
+	}
+	if lipgloss.Height(body) < contentHeight {
+		body += strings.Repeat("\n", contentHeight-lipgloss.Height(body))
+	}
+	return body
+}
+
+// bodyHeight returns the height of the page area between the header and the widgets.
+func (s *Skeleton) bodyHeight() int {
+	bodyHeight := s.viewport.Height - s.header.Height() - s.widget.Height()
+	if s.keyHelp.GetShowShortHelp() {
+		bodyHeight -= 1
+	}
+	return bodyHeight
+}
+
 func (s *Skeleton) View() string {
 	if !s.termReady {
 		return "setting up terminal..."
 	}
+	if s.zoomed {
+		return s.zoomView(s.bodyView())
+	}
 	if !s.termSizeNotEnoughToHandleHeaders {
 		return "terminal size is not enough to show headers"
 	}
@@ -483,22 +859,34 @@ func (s *Skeleton) View() string {
 		return "terminal size is not enough to show widgets"
 	}
 
-	base := lipgloss.NewStyle().
-		BorderForeground(lipgloss.Color(s.properties.borderColor)).
-		Align(s.properties.pagePosition).
-		Border(lipgloss.RoundedBorder()).
+	borderSet, _ := s.chromeBorderSet()
+	frameStyle := s.properties.frameStyle
+	base := frameStyle.
+		BorderForeground(s.frameColor()).
+		Border(borderSet.Frame).
 		BorderTop(false).BorderBottom(false).
-		Width(s.viewport.Width - 2)
+		Width(s.viewport.Width - 2 - frameStyle.GetHorizontalMargins())
 
-	body := s.pages[s.currentTab].View()
+	// the sidebar takes the left side of the frame, the page is rendered on its right
+	sidebarWidth := s.sidebarWidth()
+	page := base
+	if sidebarWidth > 0 {
+		page = base.BorderLeft(false).Width(s.viewport.Width - 2 - sidebarWidth - frameStyle.GetHorizontalMargins())
+	}
 
-	bodyHeight := s.viewport.Height - 5 // for header height and Value height
-	if len(s.widget.widgets) > 0 {
-		bodyHeight -= 1
+	body := page.Render(s.bodyView())
+	if sidebarWidth > 0 {
+		body = lipgloss.JoinHorizontal(lipgloss.Top, s.header.SidebarView(lipgloss.Height(body)), body)
 	}
-	if lipgloss.Height(body) < bodyHeight {
-		body += strings.Repeat("\n", bodyHeight-lipgloss.Height(body))
+	if s.keyHelp.GetShowShortHelp() {
+		contentWidth, _ := s.contentSize()
+		row := s.keyHelp.ShortView(contentWidth, s.shortHelp())
+		base = base.Align(lipgloss.Left).PaddingTop(0).PaddingBottom(0).MarginTop(0).MarginBottom(0)
+		body = lipgloss.JoinVertical(lipgloss.Top, body, base.Render(row))
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Top, s.header.View(), base.Render(body), s.widget.View())
+	if !s.isTabBarOnTop() {
+		return lipgloss.JoinVertical(lipgloss.Top, s.widget.View(), body, s.header.View())
+	}
+	return lipgloss.JoinVertical(lipgloss.Top, s.header.View(), body, s.widget.View())
 }
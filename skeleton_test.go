@@ -0,0 +1,127 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"testing"
+	"time"
+)
+
+// keyLog is hold the keys the pages of a test Skeleton receive.
+type keyLog struct {
+	keys []string
+}
+
+// keyRecorder is a page that records the keys it receives.
+type keyRecorder struct {
+	log *keyLog
+}
+
+func (r keyRecorder) Init() tea.Cmd { return nil }
+
+func (r keyRecorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		r.log.keys = append(r.log.keys, msg.String())
+	}
+	return r, nil
+}
+
+func (r keyRecorder) View() string { return "" }
+
+// inputRecorder is a keyRecorder that claims the key presses exclusively.
+type inputRecorder struct {
+	keyRecorder
+}
+
+func (r inputRecorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	_, cmd := r.keyRecorder.Update(msg)
+	return r, cmd
+}
+
+func (r inputRecorder) ExclusiveInput() bool { return true }
+
+// newTestSkeleton returns a Skeleton with a keyRecorder page for each of the given keys, the keys are the titles as well.
+// The returned log records the keys the pages receive.
+func newTestSkeleton(keys ...string) (*Skeleton, *keyLog) {
+	log := &keyLog{}
+	s := NewSkeleton()
+	for _, key := range keys {
+		s.AddPage(key, key, keyRecorder{log})
+	}
+	return s, log
+}
+
+// settle applies the messages the Skeleton, the header and the widgets send from their goroutines,
+// e.g. the size reports, View shows the size warnings until they are applied.
+func settle(s *Skeleton) {
+	for {
+		select {
+		case msg := <-s.updateChan:
+			s.Update(msg)
+		case msg := <-s.header.updateChan:
+			s.Update(msg)
+		case msg := <-s.widget.updateChan:
+			s.Update(msg)
+		case <-time.After(20 * time.Millisecond):
+			return
+		}
+	}
+}
+
+func TestCloseTab(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second", "third")
+	s.SetPagePinned("first", true)
+	closeTab := tea.KeyMsg{Type: tea.KeyCtrlW}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	s.Update(closeTab)
+	if len(s.pages) != 2 || s.GetActivePage() != "first" {
+		t.Fatalf("pages = %d, active page = %q, want the third page closed", len(s.pages), s.GetActivePage())
+	}
+
+	s.Update(closeTab)
+	if len(s.pages) != 2 {
+		t.Errorf("pages = %d, want the pinned page to stay open", len(s.pages))
+	}
+
+	s.SetPagePinned("first", false)
+	s.Update(closeTab)
+	s.Update(closeTab)
+	if len(s.pages) != 1 || s.GetActivePage() != "second" {
+		t.Errorf("pages = %d, active page = %q, want the last page to stay open", len(s.pages), s.GetActivePage())
+	}
+}
+
+func TestFirstAndLastTab(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second", "third")
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlEnd})
+	if s.GetActivePage() != "third" || s.header.GetCurrentTab() != 2 {
+		t.Errorf("active page = %q, want the last page", s.GetActivePage())
+	}
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlHome})
+	if s.GetActivePage() != "first" || s.header.GetCurrentTab() != 0 {
+		t.Errorf("active page = %q, want the first page", s.GetActivePage())
+	}
+}
+
+func TestLockedTabsCannotBeClosed(t *testing.T) {
+	s, _ := newTestSkeleton("first", "second")
+	s.Update(tea.WindowSizeMsg{Width: 80, Height: 10})
+	s.ShowTabCloseButton(true)
+	s.LockTabs()
+	if s.header.hasCloseButton(s.header.headers[0]) {
+		t.Error("close glyph is rendered on a locked tab")
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlW})
+	s.Update(tea.MouseMsg{X: 2, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonMiddle})
+	if len(s.pages) != 2 {
+		t.Fatalf("pages = %d, want the locked tabs to stay open", len(s.pages))
+	}
+
+	s.UnlockTabs()
+	s.Update(tea.MouseMsg{X: 2, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonMiddle})
+	if len(s.pages) != 1 {
+		t.Errorf("pages = %d, want the middle-click to close the unlocked tab", len(s.pages))
+	}
+}
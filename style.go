@@ -0,0 +1,164 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// frameSize returns the widths of the left and the right frames (margins, borders and padding) of the style.
+// Borders set only by BorderStyle are drawn on all sides by lipgloss, they are measured as well.
+func frameSize(style lipgloss.Style) (left, right int) {
+	left = style.GetMarginLeft() + style.GetPaddingLeft()
+	right = style.GetMarginRight() + style.GetPaddingRight()
+
+	border := style.GetBorderStyle()
+	if style.GetBorderLeft() || style.GetBorderRight() || style.GetBorderTop() || style.GetBorderBottom() {
+		left += style.GetBorderLeftSize()
+		right += style.GetBorderRightSize()
+	} else if border != (lipgloss.Border{}) {
+		left += lipgloss.Width(border.Left)
+		right += lipgloss.Width(border.Right)
+	}
+
+	return left, right
+}
+
+// textStyle returns the text attributes of the style without its frame (margins, borders and padding) and size.
+// It renders the parts of a content that are styled apart, e.g. the title of a tab around its badge.
+func textStyle(style lipgloss.Style) lipgloss.Style {
+	return style.UnsetMargins().UnsetPadding().
+		UnsetBorderStyle().UnsetBorderTop().UnsetBorderRight().UnsetBorderBottom().UnsetBorderLeft().
+		UnsetWidth().UnsetHeight().UnsetMaxWidth().UnsetMaxHeight().UnsetAlign()
+}
+
+// inheritStyle returns the style with the given border if it has none, and with the colors of the previous style
+// if it does not set them. It lets the custom styles keep the colors of the theme.
+func inheritStyle(style, previous lipgloss.Style, border lipgloss.Border) lipgloss.Style {
+	if style.GetBorderStyle() == (lipgloss.Border{}) {
+		style = style.BorderStyle(border)
+	}
+	if _, ok := style.GetForeground().(lipgloss.NoColor); ok {
+		style = style.Foreground(previous.GetForeground())
+	}
+	if _, ok := style.GetBorderTopForeground().(lipgloss.NoColor); ok {
+		style = style.BorderForeground(previous.GetBorderTopForeground())
+	}
+	return style
+}
+
+// styleColors is hold the text and the border colors of a style, nil colors are not set.
+type styleColors struct {
+	text   lipgloss.TerminalColor
+	border lipgloss.TerminalColor
+}
+
+// colorsOf returns the text and the border colors the style sets.
+func colorsOf(style lipgloss.Style) styleColors {
+	var colors styleColors
+	if _, ok := style.GetForeground().(lipgloss.NoColor); !ok {
+		colors.text = style.GetForeground()
+	}
+	if _, ok := style.GetBorderTopForeground().(lipgloss.NoColor); !ok {
+		colors.border = style.GetBorderTopForeground()
+	}
+	return colors
+}
+
+// or returns the colors with the given fallback colors where they are not set.
+func (c styleColors) or(fallback styleColors) styleColors {
+	if c.text == nil {
+		c.text = fallback.text
+	}
+	if c.border == nil {
+		c.border = fallback.border
+	}
+	return c
+}
+
+// paintStyle returns the style with the given text and border colors, nil colors are unset.
+func paintStyle(style lipgloss.Style, colors styleColors) lipgloss.Style {
+	style = style.UnsetForeground().UnsetBorderForeground()
+	if colors.text != nil {
+		style = style.Foreground(colors.text)
+	}
+	if colors.border != nil {
+		style = style.BorderForeground(colors.border)
+	}
+	return style
+}
+
+// SetActiveTabStyle sets the style of the active tab, e.g. its padding, margins, border and colors.
+// The border of the border set is used if the style has no border, and the theme colors are used if the style has no colors.
+// The colors the style sets are kept when the theme or a color setter (e.g. SetBorderColor) changes.
+func (s *Skeleton) SetActiveTabStyle(style lipgloss.Style) *Skeleton {
+	s.header.SetActiveTabStyle(style)
+	s.triggerUpdate()
+	return s
+}
+
+// GetActiveTabStyle returns the style of the active tab.
+func (s *Skeleton) GetActiveTabStyle() lipgloss.Style {
+	return s.header.GetActiveTabStyle()
+}
+
+// SetInactiveTabStyle sets the style of the inactive tabs, the border and the colors the style does not set are kept.
+func (s *Skeleton) SetInactiveTabStyle(style lipgloss.Style) *Skeleton {
+	s.header.SetInactiveTabStyle(style)
+	s.triggerUpdate()
+	return s
+}
+
+// GetInactiveTabStyle returns the style of the inactive tabs.
+func (s *Skeleton) GetInactiveTabStyle() lipgloss.Style {
+	return s.header.GetInactiveTabStyle()
+}
+
+// SetDisabledTabStyle sets the style of the tabs while the tabs are locked, the border and the colors the style does not set are kept.
+func (s *Skeleton) SetDisabledTabStyle(style lipgloss.Style) *Skeleton {
+	s.header.SetDisabledTabStyle(style)
+	s.triggerUpdate()
+	return s
+}
+
+// GetDisabledTabStyle returns the style of the tabs while the tabs are locked.
+func (s *Skeleton) GetDisabledTabStyle() lipgloss.Style {
+	return s.header.GetDisabledTabStyle()
+}
+
+// SetWidgetStyle sets the style of the widgets on the widget bar and the header, the border and the colors the style does not set are kept.
+// The colors the style sets are kept over the theme colors, SetWidgetBorderColor replaces its border color.
+func (s *Skeleton) SetWidgetStyle(style lipgloss.Style) *Skeleton {
+	s.widget.SetWidgetStyle(style)
+	s.header.calculateTitleLength() // the widgets of the header share the style
+	s.triggerUpdate()
+	return s
+}
+
+// GetWidgetStyle returns the style of the widgets on the widget bar and the header.
+func (s *Skeleton) GetWidgetStyle() lipgloss.Style {
+	return s.widget.GetWidgetStyle()
+}
+
+// SetAppTitleStyle sets the style of the application title, the colors the style does not set are kept.
+func (s *Skeleton) SetAppTitleStyle(style lipgloss.Style) *Skeleton {
+	s.header.SetAppTitleStyle(style)
+	s.triggerUpdate()
+	return s
+}
+
+// GetAppTitleStyle returns the style of the application title.
+func (s *Skeleton) GetAppTitleStyle() lipgloss.Style {
+	return s.header.GetAppTitleStyle()
+}
+
+// SetFrameStyle sets the style of the frame around the pages, e.g. the alignment and the padding of the page content.
+// The borders of the frame are always drawn by the border set and the theme, the borders of the style are ignored.
+func (s *Skeleton) SetFrameStyle(style lipgloss.Style) *Skeleton {
+	s.properties.frameStyle = style
+	s.triggerUpdate()
+	return s
+}
+
+// GetFrameStyle returns the style of the frame around the pages.
+func (s *Skeleton) GetFrameStyle() lipgloss.Style {
+	return s.properties.frameStyle
+}
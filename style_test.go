@@ -0,0 +1,77 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"testing"
+)
+
+func TestFrameSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		style       lipgloss.Style
+		left, right int
+	}{
+		{"no frame", lipgloss.NewStyle(), 0, 0},
+		{"padding", lipgloss.NewStyle().Padding(0, 2), 2, 2},
+		{"margins and padding", lipgloss.NewStyle().Margin(0, 1, 0, 3).PaddingLeft(1), 4, 1},
+		{"border style only", lipgloss.NewStyle().BorderStyle(lipgloss.RoundedBorder()).Padding(0, 1), 2, 2},
+		{"left border only", lipgloss.NewStyle().Border(lipgloss.NormalBorder(), false, false, false, true), 1, 0},
+		{"thick border", lipgloss.NewStyle().BorderStyle(lipgloss.ThickBorder()).Margin(0, 2), 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := frameSize(tt.style)
+			if left != tt.left || right != tt.right {
+				t.Errorf("frameSize() = %d, %d, want %d, %d", left, right, tt.left, tt.right)
+			}
+			if width := lipgloss.Width(tt.style.Render("x")); width != tt.left+1+tt.right {
+				t.Errorf("rendered width = %d, want %d by the frame size", width, tt.left+1+tt.right)
+			}
+		})
+	}
+}
+
+func TestInheritStyle(t *testing.T) {
+	red, blue := lipgloss.Color("#ff0000"), lipgloss.Color("#0000ff")
+	previous := lipgloss.NewStyle().Foreground(red).BorderForeground(blue)
+	border := lipgloss.RoundedBorder()
+
+	tests := []struct {
+		name        string
+		style       lipgloss.Style
+		text, color lipgloss.TerminalColor
+		border      lipgloss.Border
+	}{
+		{"empty style", lipgloss.NewStyle(), red, blue, border},
+		{"own colors", lipgloss.NewStyle().Foreground(blue).BorderForeground(red), blue, red, border},
+		{"own text color", lipgloss.NewStyle().Foreground(blue), blue, blue, border},
+		{"own border", lipgloss.NewStyle().BorderStyle(lipgloss.DoubleBorder()), red, blue, lipgloss.DoubleBorder()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inheritStyle(tt.style, previous, border)
+			if got.GetForeground() != tt.text || got.GetBorderTopForeground() != tt.color {
+				t.Errorf("colors = %v, %v, want %v, %v", got.GetForeground(), got.GetBorderTopForeground(), tt.text, tt.color)
+			}
+			if got.GetBorderStyle() != tt.border {
+				t.Errorf("border = %+v, want %+v", got.GetBorderStyle(), tt.border)
+			}
+		})
+	}
+}
+
+func TestPaintStyle(t *testing.T) {
+	red := lipgloss.Color("#ff0000")
+	style := lipgloss.NewStyle().Foreground(red).BorderForeground(red)
+
+	custom := colorsOf(lipgloss.NewStyle().Foreground(lipgloss.Color("#00ff00")))
+	got := paintStyle(style, custom.or(styleColors{text: red}))
+	if got.GetForeground() != lipgloss.Color("#00ff00") {
+		t.Errorf("foreground = %v, want the custom color over the fallback", got.GetForeground())
+	}
+	if _, ok := got.GetBorderTopForeground().(lipgloss.NoColor); !ok {
+		t.Errorf("border color = %v, want nil colors to be unset", got.GetBorderTopForeground())
+	}
+}
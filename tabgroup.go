@@ -0,0 +1,61 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WithAccent sets the accent color of the page, it tints the border of its tab, e.g. red for the production pages.
+// It overrides the color of the tab group.
+func WithAccent(color lipgloss.TerminalColor) PageOption {
+	return func(hdr *commonHeader) {
+		hdr.accent = color
+	}
+}
+
+// WithAccentFrame tints the outer frame with the accent color of the page, or the color of its tab group, while the page is active.
+func WithAccentFrame() PageOption {
+	return func(hdr *commonHeader) {
+		hdr.accentFrame = true
+	}
+}
+
+// WithGroup adds the page to the named tab group. The tabs of a group share the color of the group,
+// the name of the group is rendered before the first tab of each run of the group.
+func WithGroup(name string) PageOption {
+	return func(hdr *commonHeader) {
+		hdr.group = name
+	}
+}
+
+// SetTabGroupColor sets the color of the named tab group, it tints the borders of the tabs and the label of the group.
+func (s *Skeleton) SetTabGroupColor(name string, color lipgloss.TerminalColor) *Skeleton {
+	s.header.SetGroupColor(name, color)
+	s.triggerUpdate()
+	return s
+}
+
+// GetTabGroupColor returns the color of the named tab group, it is nil if the group has no color.
+func (s *Skeleton) GetTabGroupColor(name string) lipgloss.TerminalColor {
+	return s.header.GetGroupColor(name)
+}
+
+// SetPageAccent sets the accent color of the page by the given key, nil removes it.
+func (s *Skeleton) SetPageAccent(key string, color lipgloss.TerminalColor) *Skeleton {
+	s.header.SetAccent(key, color)
+	s.triggerUpdate()
+	return s
+}
+
+// updateFrameAccent tints the frame line of the widgets with the accent color of the active page.
+// It is called at the end of the update, after the active page, its accent or its group is changed.
+func (s *Skeleton) updateFrameAccent() {
+	s.widget.setFrameAccent(s.header.frameAccent())
+}
+
+// frameColor returns the color of the outer frame, it is the accent color of the active page if it tints the frame.
+func (s *Skeleton) frameColor() lipgloss.TerminalColor {
+	if accent := s.header.frameAccent(); accent != nil {
+		return accent
+	}
+	return s.chromeTheme().Border
+}
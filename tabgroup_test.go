@@ -0,0 +1,30 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"testing"
+)
+
+func TestFrameAccentFollowsTheActivePage(t *testing.T) {
+	red := lipgloss.Color("1")
+	s, rec := newTestSkeleton("dev")
+	s.SetMonochrome(false) // the accent colors are not used without colors
+	s.AddPage("prod", "Prod", keyRecorder{rec}, WithAccent(red), WithAccentFrame())
+	s.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+
+	if s.widget.properties.frameAccent != nil {
+		t.Fatalf("frame accent = %v, want none on the page without an accent frame", s.widget.properties.frameAccent)
+	}
+
+	s.Update(tea.KeyMsg{Type: tea.KeyCtrlRight})
+	if s.widget.properties.frameAccent != red {
+		t.Errorf("frame accent = %v, want the accent of the active page", s.widget.properties.frameAccent)
+	}
+
+	s.SetPageAccent("prod", nil)
+	s.Update(DummyMsg{})
+	if s.widget.properties.frameAccent != nil {
+		t.Errorf("frame accent = %v, want none after the accent is removed", s.widget.properties.frameAccent)
+	}
+}
@@ -0,0 +1,153 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TabOverflow is the strategy of the tab bar for the tabs that do not fit into a single row.
+type TabOverflow int
+
+const (
+	// TabOverflowScroll scrolls the tabs around the current tab, the hidden tabs are counted on the indicators
+	TabOverflowScroll TabOverflow = iota
+
+	// TabOverflowWrap wraps the tabs onto more rows, the row of the current tab is next to the pages
+	TabOverflowWrap
+)
+
+// SetTabOverflow sets the strategy of the tab bar for the tabs that do not fit into a single row.
+// The wrapped rows take the height of the pages, ContentSizeMsg reports the new size to the pages.
+func (s *Skeleton) SetTabOverflow(overflow TabOverflow) *Skeleton {
+	s.header.SetTabOverflow(overflow)
+	s.triggerUpdate()
+	return s
+}
+
+// GetTabOverflow returns the strategy of the tab bar for the tabs that do not fit into a single row.
+func (s *Skeleton) GetTabOverflow() TabOverflow {
+	return s.header.GetTabOverflow()
+}
+
+// SetTabOverflow sets the strategy of the tab bar for the tabs that do not fit into a single row.
+func (h *header) SetTabOverflow(overflow TabOverflow) {
+	h.properties.tabOverflow = overflow
+	h.calculateTitleLength()
+}
+
+// GetTabOverflow returns the strategy of the tab bar for the tabs that do not fit into a single row.
+func (h *header) GetTabOverflow() TabOverflow {
+	return h.properties.tabOverflow
+}
+
+// wrapping returns the tabs wrap onto more rows or not, they do not wrap while they are on the sidebar.
+func (h *header) wrapping() bool {
+	return h.properties.tabOverflow == TabOverflowWrap && !h.properties.sidebar
+}
+
+// wrapTabs breaks the tabs into the rows of the available width and returns the range of the row of the current tab.
+// ok is false if a tab is wider than a row.
+func (h *header) wrapTabs(widths []int, available int) (start, end int, ok bool) {
+	h.rows = h.rows[:0]
+	ok = true
+
+	var rowWidth int
+	for i, width := range widths {
+		if i == 0 || rowWidth+width > available {
+			h.rows = append(h.rows, i)
+			rowWidth = 0
+		}
+		rowWidth += width
+		ok = ok && width <= available
+	}
+
+	start, end = h.rowRange(h.activeRow())
+	return start, end, ok
+}
+
+// rowRange returns the range [start, end) of the tabs on the given row.
+func (h *header) rowRange(row int) (start, end int) {
+	if row < 0 || row >= len(h.rows) {
+		return 0, 0
+	}
+
+	end = len(h.headers)
+	if row+1 < len(h.rows) {
+		end = h.rows[row+1]
+	}
+	return h.rows[row], end
+}
+
+// activeRow returns the row of the current tab.
+func (h *header) activeRow() int {
+	for row := len(h.rows) - 1; row >= 0; row-- {
+		if h.rows[row] <= h.currentTab {
+			return row
+		}
+	}
+	return 0
+}
+
+// rowOrder returns the rows in the order they are rendered, the row of the current tab is the closest one to the pages.
+func (h *header) rowOrder() []int {
+	active := h.activeRow()
+
+	order := make([]int, 0, len(h.rows))
+	for row := range h.rows {
+		if row != active {
+			order = append(order, row)
+		}
+	}
+	if h.properties.position == lipgloss.Bottom {
+		return append([]int{active}, order...)
+	}
+	return append(order, active)
+}
+
+// frameRowTop returns the first line of the row that is joined to the frame, the other rows are above or below it.
+func (h *header) frameRowTop() int {
+	if !h.wrapping() || h.properties.position == lipgloss.Bottom {
+		return 0
+	}
+	return h.Height() - headerHeight
+}
+
+// wrappedView returns the given frame row with the other rows of the wrapped tabs, they are aligned with the frame row.
+func (h *header) wrappedView(frameRow string) string {
+	active := h.activeRow()
+
+	rendered := make([]string, 0, len(h.rows))
+	for _, row := range h.rowOrder() {
+		if row == active {
+			rendered = append(rendered, frameRow)
+			continue
+		}
+
+		start, end := h.rowRange(row)
+		tabs := []string{" "} // for the corner of the frame row
+		for i := start; i < end; i++ {
+			tabs = append(tabs, h.renderTab(i))
+		}
+		rendered = append(rendered, lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rendered...)
+}
+
+// isFloating returns the tab by the given index is on a row of the wrapped tabs other than the frame row or not.
+func (h *header) isFloating(index int) bool {
+	if !h.wrapping() || len(h.rows) == 0 {
+		return false
+	}
+	start, end := h.rowRange(h.activeRow())
+	return index < start || index >= end
+}
+
+// wrappedTabAt returns the index of the tab at the given position on the rows of the wrapped tabs other than the frame row.
+func (h *header) wrappedTabAt(x, y int) (index int, onClose bool, ok bool) {
+	order := h.rowOrder()
+	if y < 0 || y/headerHeight >= len(order) {
+		return 0, false, false
+	}
+
+	start, end := h.rowRange(order[y/headerHeight])
+	return h.tabInRange(x, 1, start, end) // for the corner of the frame row
+}
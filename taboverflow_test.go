@@ -0,0 +1,33 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+func TestFloatingRowsHaveNoJoints(t *testing.T) {
+	h := newHeader()
+	h.SetTabOverflow(TabOverflowWrap)
+	for i := 0; i < 12; i++ {
+		h.AddCommonHeader(fmt.Sprintf("page-%d", i), fmt.Sprintf("Page %d", i))
+	}
+	h.Update(tea.WindowSizeMsg{Width: 50, Height: 20})
+
+	lines := strings.Split(ansi.Strip(h.View()), "\n")
+	if len(h.rows) < 2 || len(lines) != h.Height() {
+		t.Fatalf("rows = %d, view height = %d, want the tabs wrapped onto the rows of the header", len(h.rows), len(lines))
+	}
+
+	floating, frameRow := lines[:len(lines)-headerHeight], lines[len(lines)-headerHeight:]
+	for _, line := range floating {
+		if strings.ContainsAny(line, "┤├╡╞") {
+			t.Errorf("floating row %q has joints", line)
+		}
+	}
+	if !strings.Contains(frameRow[1], "┤") || !strings.Contains(frameRow[1], "├") {
+		t.Errorf("frame row %q has no joints", frameRow[1])
+	}
+}
@@ -0,0 +1,234 @@
+package skeleton
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is hold the colors of the Skeleton, SetTheme applies all of them at once.
+// The colors are lipgloss.TerminalColor values, the presets, the theme files and GetTheme all use it:
+// lipgloss.Color, lipgloss.AdaptiveColor (light and dark terminals) or lipgloss.CompleteColor
+// (true color, 256 and 16 color terminals). The colors the custom styles set (e.g. SetActiveTabStyle) are kept over the theme,
+// nil colors fall back to them or to the default color of the terminal.
+type Theme struct {
+	// Name is the name of the theme, it is shown on the command palette
+	Name string
+
+	// Border is the color of the frame lines, the overlay borders, the prompts and the help titles
+	Border lipgloss.TerminalColor
+
+	// Text is the color of the overlay items
+	Text lipgloss.TerminalColor
+
+	// Muted is the color of the secondary texts, e.g. the key bindings on the command palette
+	Muted lipgloss.TerminalColor
+
+	// Accent is the color of the selected overlay item, the indicator on the widget bar and the info badges
+	Accent lipgloss.TerminalColor
+
+	// Warning is the color of the warning badges
+	Warning lipgloss.TerminalColor
+
+	// Error is the color of the error reports and the error badges
+	Error lipgloss.TerminalColor
+
+	// ActiveTabText is the color of the title of the active tab
+	ActiveTabText lipgloss.TerminalColor
+
+	// ActiveTabBorder is the color of the border of the active tab
+	ActiveTabBorder lipgloss.TerminalColor
+
+	// InactiveTabText is the color of the titles of the inactive tabs
+	InactiveTabText lipgloss.TerminalColor
+
+	// InactiveTabBorder is the color of the borders of the inactive tabs
+	InactiveTabBorder lipgloss.TerminalColor
+
+	// DisabledTabText is the color of the titles of the tabs while the tabs are locked
+	DisabledTabText lipgloss.TerminalColor
+
+	// DisabledTabBorder is the color of the borders of the tabs while the tabs are locked
+	DisabledTabBorder lipgloss.TerminalColor
+
+	// WidgetText is the color of the values of the widgets
+	WidgetText lipgloss.TerminalColor
+
+	// WidgetBorder is the color of the borders of the widgets
+	WidgetBorder lipgloss.TerminalColor
+}
+
+// ThemeChanged is sent to the pages when the theme is changed by SetTheme.
+// Pages may rebuild their styles by the given theme.
+type ThemeChanged struct {
+	Theme Theme
+}
+
+// DefaultTheme returns the default theme of the Skeleton.
+func DefaultTheme() Theme {
+	return Theme{
+		Name:              "Default",
+		Border:            lipgloss.Color("39"),
+		Text:              lipgloss.AdaptiveColor{Light: "236", Dark: "255"},
+		Muted:             lipgloss.Color("240"),
+		Accent:            lipgloss.Color("205"),
+		Warning:           lipgloss.Color("214"),
+		Error:             lipgloss.Color("196"),
+		ActiveTabBorder:   lipgloss.Color("205"),
+		InactiveTabBorder: lipgloss.AdaptiveColor{Light: "236", Dark: "255"},
+		DisabledTabText:   lipgloss.Color("240"),
+		DisabledTabBorder: lipgloss.Color("240"),
+		WidgetBorder:      lipgloss.Color("49"),
+	}
+}
+
+// NordTheme returns the theme based on the Nord palette.
+func NordTheme() Theme {
+	return Theme{
+		Name:              "Nord",
+		Border:            lipgloss.Color("#81A1C1"),
+		Text:              lipgloss.Color("#ECEFF4"),
+		Muted:             lipgloss.Color("#4C566A"),
+		Accent:            lipgloss.Color("#88C0D0"),
+		Warning:           lipgloss.Color("#EBCB8B"),
+		Error:             lipgloss.Color("#BF616A"),
+		ActiveTabText:     lipgloss.Color("#ECEFF4"),
+		ActiveTabBorder:   lipgloss.Color("#88C0D0"),
+		InactiveTabText:   lipgloss.Color("#D8DEE9"),
+		InactiveTabBorder: lipgloss.Color("#4C566A"),
+		DisabledTabText:   lipgloss.Color("#4C566A"),
+		DisabledTabBorder: lipgloss.Color("#3B4252"),
+		WidgetText:        lipgloss.Color("#E5E9F0"),
+		WidgetBorder:      lipgloss.Color("#A3BE8C"),
+	}
+}
+
+// DraculaTheme returns the theme based on the Dracula palette.
+func DraculaTheme() Theme {
+	return Theme{
+		Name:              "Dracula",
+		Border:            lipgloss.Color("#6272A4"),
+		Text:              lipgloss.Color("#F8F8F2"),
+		Muted:             lipgloss.Color("#6272A4"),
+		Accent:            lipgloss.Color("#FF79C6"),
+		Warning:           lipgloss.Color("#F1FA8C"),
+		Error:             lipgloss.Color("#FF5555"),
+		ActiveTabText:     lipgloss.Color("#F8F8F2"),
+		ActiveTabBorder:   lipgloss.Color("#FF79C6"),
+		InactiveTabText:   lipgloss.Color("#F8F8F2"),
+		InactiveTabBorder: lipgloss.Color("#BD93F9"),
+		DisabledTabText:   lipgloss.Color("#44475A"),
+		DisabledTabBorder: lipgloss.Color("#44475A"),
+		WidgetText:        lipgloss.Color("#F8F8F2"),
+		WidgetBorder:      lipgloss.Color("#50FA7B"),
+	}
+}
+
+// GruvboxTheme returns the theme based on the Gruvbox dark palette.
+func GruvboxTheme() Theme {
+	return Theme{
+		Name:              "Gruvbox",
+		Border:            lipgloss.Color("#83A598"),
+		Text:              lipgloss.Color("#EBDBB2"),
+		Muted:             lipgloss.Color("#928374"),
+		Accent:            lipgloss.Color("#FE8019"),
+		Warning:           lipgloss.Color("#FABD2F"),
+		Error:             lipgloss.Color("#FB4934"),
+		ActiveTabText:     lipgloss.Color("#EBDBB2"),
+		ActiveTabBorder:   lipgloss.Color("#FE8019"),
+		InactiveTabText:   lipgloss.Color("#D5C4A1"),
+		InactiveTabBorder: lipgloss.Color("#A89984"),
+		DisabledTabText:   lipgloss.Color("#665C54"),
+		DisabledTabBorder: lipgloss.Color("#665C54"),
+		WidgetText:        lipgloss.Color("#EBDBB2"),
+		WidgetBorder:      lipgloss.Color("#B8BB26"),
+	}
+}
+
+// SolarizedLightTheme returns the theme based on the Solarized light palette, it suits the light terminals.
+func SolarizedLightTheme() Theme {
+	return Theme{
+		Name:              "Solarized Light",
+		Border:            lipgloss.Color("#268BD2"),
+		Text:              lipgloss.Color("#586E75"),
+		Muted:             lipgloss.Color("#93A1A1"),
+		Accent:            lipgloss.Color("#D33682"),
+		Warning:           lipgloss.Color("#B58900"),
+		Error:             lipgloss.Color("#DC322F"),
+		ActiveTabText:     lipgloss.Color("#073642"),
+		ActiveTabBorder:   lipgloss.Color("#D33682"),
+		InactiveTabText:   lipgloss.Color("#586E75"),
+		InactiveTabBorder: lipgloss.Color("#93A1A1"),
+		DisabledTabText:   lipgloss.Color("#93A1A1"),
+		DisabledTabBorder: lipgloss.Color("#EEE8D5"),
+		WidgetText:        lipgloss.Color("#586E75"),
+		WidgetBorder:      lipgloss.Color("#859900"),
+	}
+}
+
+// Themes returns the built-in themes.
+func Themes() []Theme {
+	return []Theme{DefaultTheme(), NordTheme(), DraculaTheme(), GruvboxTheme(), SolarizedLightTheme()}
+}
+
+// SetTheme applies the colors of the theme to the frame, the tabs, the widgets and the overlays at once.
+// The pages receive the ThemeChanged message.
+func (s *Skeleton) SetTheme(theme Theme) *Skeleton {
+	s.properties.theme = theme
+	s.applyTheme()
+
+	go func() {
+		s.updateChan <- ThemeChanged{Theme: theme}
+	}()
+	return s
+}
+
+// GetTheme returns the current theme, the color setters (e.g. SetBorderColor) are reflected on it.
+// Pages may use it for their own styling.
+func (s *Skeleton) GetTheme() Theme {
+	return s.properties.theme
+}
+
+// applyTheme applies the current theme to the header, the widgets and the overlays, the colors of the custom styles are kept.
+func (s *Skeleton) applyTheme() {
+	theme := s.chromeTheme()
+	monochrome := s.properties.monochrome
+
+	s.header.setMonochrome(monochrome)
+	s.header.applyTheme(theme)
+	s.widget.setMonochrome(monochrome)
+	s.widget.applyTheme(theme)
+	s.overlay.applyTheme(theme)
+	s.overlay.setMonochrome(monochrome)
+	s.keyHelp.applyTheme(theme)
+	s.keyHelp.setMonochrome(monochrome)
+}
+
+// chromeTheme returns the theme the frame, the tabs and the widgets are rendered with,
+// it has no colors in monochrome mode.
+func (s *Skeleton) chromeTheme() Theme {
+	if s.properties.monochrome {
+		return Theme{Name: s.properties.theme.Name}
+	}
+	return s.properties.theme
+}
+
+// themeCommands returns the commands that switch to the built-in themes except the current one.
+func (s *Skeleton) themeCommands() []*command {
+	var commands []*command
+	for _, theme := range Themes() {
+		if theme.Name == s.properties.theme.Name {
+			continue
+		}
+
+		commands = append(commands, &command{
+			name:        fmt.Sprintf("Theme: %s", theme.Name),
+			description: "switch the color theme",
+			run: func() tea.Cmd {
+				s.SetTheme(theme)
+				return nil
+			},
+		})
+	}
+	return commands
+}
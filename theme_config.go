@@ -0,0 +1,241 @@
+package skeleton
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// themeErrorWidget is the key of the widget that reports the errors of the theme file.
+const themeErrorWidget = "skeleton.theme"
+
+// themeColorFields maps the keys of the theme file to the colors of the Theme.
+var themeColorFields = []struct {
+	key   string
+	color func(*Theme) *lipgloss.TerminalColor
+}{
+	{"border", func(t *Theme) *lipgloss.TerminalColor { return &t.Border }},
+	{"text", func(t *Theme) *lipgloss.TerminalColor { return &t.Text }},
+	{"muted", func(t *Theme) *lipgloss.TerminalColor { return &t.Muted }},
+	{"accent", func(t *Theme) *lipgloss.TerminalColor { return &t.Accent }},
+	{"warning", func(t *Theme) *lipgloss.TerminalColor { return &t.Warning }},
+	{"error", func(t *Theme) *lipgloss.TerminalColor { return &t.Error }},
+	{"active_tab_text", func(t *Theme) *lipgloss.TerminalColor { return &t.ActiveTabText }},
+	{"active_tab_border", func(t *Theme) *lipgloss.TerminalColor { return &t.ActiveTabBorder }},
+	{"inactive_tab_text", func(t *Theme) *lipgloss.TerminalColor { return &t.InactiveTabText }},
+	{"inactive_tab_border", func(t *Theme) *lipgloss.TerminalColor { return &t.InactiveTabBorder }},
+	{"disabled_tab_text", func(t *Theme) *lipgloss.TerminalColor { return &t.DisabledTabText }},
+	{"disabled_tab_border", func(t *Theme) *lipgloss.TerminalColor { return &t.DisabledTabBorder }},
+	{"widget_text", func(t *Theme) *lipgloss.TerminalColor { return &t.WidgetText }},
+	{"widget_border", func(t *Theme) *lipgloss.TerminalColor { return &t.WidgetBorder }},
+}
+
+// hexColorPattern matches the #RGB and #RRGGBB colors.
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// LoadTheme reads the theme from the JSON file and applies it. The missing colors are taken from DefaultTheme:
+//
+//	{
+//	  "name": "Ocean",
+//	  "border": "#268BD2",
+//	  "inactive_tab_border": {"light": "236", "dark": "255"},
+//	  "widget_border": {"true_color": "#04B575", "ansi256": "35", "ansi": "2"}
+//	}
+//
+// Invalid colors are reported through a widget and fall back to the default colors, the rest of the theme is applied.
+// The widget is removed once the file is valid again.
+func (s *Skeleton) LoadTheme(path string) error {
+	theme, err := readThemeFile(path)
+	if theme != nil {
+		s.SetTheme(*theme)
+	}
+
+	s.setThemeError(err)
+	s.triggerUpdate()
+	return err
+}
+
+// setThemeError shows the error of the theme file on the widget, nil error removes the widget.
+// The widget is changed at once, so the result of the last load is shown even if the file changes quickly.
+func (s *Skeleton) setThemeError(err error) {
+	if err == nil {
+		s.widget.SetAlert(themeErrorWidget, false)
+		s.widget.deleteWidget(themeErrorWidget)
+		return
+	}
+
+	s.widget.SetAlert(themeErrorWidget, true)
+	if s.widget.GetWidget(themeErrorWidget) == nil {
+		s.widget.addNewWidget(themeErrorWidget, themeErrorSummary(err))
+		return
+	}
+	s.widget.updateWidgetContent(themeErrorWidget, themeErrorSummary(err))
+}
+
+// themeFileChanged is sent when the watched theme file changes, the file is loaded on the program goroutine.
+type themeFileChanged struct {
+	path string
+}
+
+// WatchTheme loads the theme file and checks it by the given interval, the theme is reloaded when the file changes.
+// It is useful while tweaking the colors of a running program. The returned function stops watching.
+// The watcher only detects the changes, the file is reloaded by the Update of the Skeleton.
+func (s *Skeleton) WatchTheme(path string, interval time.Duration) (stop func()) {
+	_ = s.LoadTheme(path)
+	last := themeFileStamp(path)
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if stamp := themeFileStamp(path); stamp != last {
+					last = stamp
+					select {
+					case s.updateChan <- themeFileChanged{path: path}:
+					case <-done:
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// themeFileStamp returns the modification time and the size of the file, it is empty if the file is missing.
+func themeFileStamp(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size())
+}
+
+// themeErrorSummary returns the first error of the theme file with the count of the others.
+func themeErrorSummary(err error) string {
+	messages := strings.Split(err.Error(), "\n")
+	summary := strings.TrimPrefix(messages[0], "skeleton: ")
+	if len(messages) > 1 {
+		summary += fmt.Sprintf(" (+%d more)", len(messages)-1)
+	}
+	return summary
+}
+
+// readThemeFile reads the theme from the JSON file.
+// It returns the theme without the invalid colors along with the errors, the theme is nil if the file can not be read.
+func readThemeFile(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("skeleton: could not read theme: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("skeleton: invalid theme JSON %s: %w", filepath.Base(path), err)
+	}
+
+	theme := DefaultTheme()
+	theme.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	var errs []error
+	if raw, ok := fields["name"]; ok {
+		if err := json.Unmarshal(raw, &theme.Name); err != nil {
+			errs = append(errs, errors.New("skeleton: theme \"name\": must be a string"))
+		}
+		delete(fields, "name")
+	}
+
+	for _, field := range themeColorFields {
+		raw, ok := fields[field.key]
+		if !ok {
+			continue
+		}
+		delete(fields, field.key)
+
+		color, err := parseThemeColor(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("skeleton: theme %q: %w", field.key, err))
+			continue
+		}
+		*field.color(&theme) = color
+	}
+
+	unknown := make([]string, 0, len(fields))
+	for key := range fields {
+		unknown = append(unknown, key)
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		errs = append(errs, fmt.Errorf("skeleton: theme %q: unknown color", key))
+	}
+
+	return &theme, errors.Join(errs...)
+}
+
+// themeColor is hold the object form of a color in the theme file.
+type themeColor struct {
+	Light     string `json:"light"`
+	Dark      string `json:"dark"`
+	TrueColor string `json:"true_color"`
+	ANSI256   string `json:"ansi256"`
+	ANSI      string `json:"ansi"`
+}
+
+// parseThemeColor parses a color of the theme file.
+// It is a string, an adaptive color ({"light", "dark"}) or a complete color ({"true_color", "ansi256", "ansi"}).
+// Empty string means the default color of the terminal.
+func parseThemeColor(raw json.RawMessage) (lipgloss.TerminalColor, error) {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return nil, nil
+		}
+		return lipgloss.Color(text), validateColor(text)
+	}
+
+	var object themeColor
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, errors.New("color must be a string, {\"light\", \"dark\"} or {\"true_color\", \"ansi256\", \"ansi\"}")
+	}
+
+	if object.Light != "" || object.Dark != "" {
+		if object.TrueColor != "" || object.ANSI256 != "" || object.ANSI != "" {
+			return nil, errors.New("adaptive and complete colors can not be mixed")
+		}
+		return lipgloss.AdaptiveColor{Light: object.Light, Dark: object.Dark}, errors.Join(validateColor(object.Light), validateColor(object.Dark))
+	}
+
+	return lipgloss.CompleteColor{TrueColor: object.TrueColor, ANSI256: object.ANSI256, ANSI: object.ANSI},
+		errors.Join(validateColor(object.TrueColor), validateColor(object.ANSI256), validateColor(object.ANSI))
+}
+
+// validateColor checks the color is a hex color (#RGB, #RRGGBB) or an ANSI color number (0-255).
+func validateColor(color string) error {
+	if hexColorPattern.MatchString(color) {
+		return nil
+	}
+	if number, err := strconv.Atoi(color); err == nil && number >= 0 && number <= 255 {
+		return nil
+	}
+	return fmt.Errorf("invalid color %q", color)
+}
@@ -0,0 +1,103 @@
+package skeleton
+
+import (
+	"encoding/json"
+	"github.com/charmbracelet/lipgloss"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseThemeColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    lipgloss.TerminalColor
+		wantErr bool
+	}{
+		{"hex", `"#268BD2"`, lipgloss.Color("#268BD2"), false},
+		{"short hex", `"#fff"`, lipgloss.Color("#fff"), false},
+		{"ansi", `"42"`, lipgloss.Color("42"), false},
+		{"empty is the terminal default", `""`, nil, false},
+		{"adaptive", `{"light": "236", "dark": "255"}`, lipgloss.AdaptiveColor{Light: "236", Dark: "255"}, false},
+		{"complete", `{"true_color": "#04B575", "ansi256": "35", "ansi": "2"}`,
+			lipgloss.CompleteColor{TrueColor: "#04B575", ANSI256: "35", ANSI: "2"}, false},
+		{"invalid name", `"blue"`, lipgloss.Color("blue"), true},
+		{"out of range", `"256"`, lipgloss.Color("256"), true},
+		{"mixed forms", `{"light": "1", "ansi": "2"}`, nil, true},
+		{"unknown field", `{"dark": "1", "bright": "2"}`, nil, true},
+		{"number", `12`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseThemeColor(json.RawMessage(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("color = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadThemeFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	theme, err := readThemeFile(write("ocean.json", `{"border": "#268BD2"}`))
+	if err != nil {
+		t.Fatalf("valid theme: %v", err)
+	}
+	if theme.Name != "ocean" || theme.Border != lipgloss.Color("#268BD2") || theme.Text != DefaultTheme().Text {
+		t.Errorf("theme = %+v, want the name of the file, the border and the default text color", theme)
+	}
+
+	theme, err = readThemeFile(write("partial.json", `{"name": "Partial", "border": "nope", "glow": "1", "text": "7"}`))
+	if err == nil || theme == nil {
+		t.Fatalf("invalid colors: theme %v, err %v, want both", theme, err)
+	}
+	if theme.Name != "Partial" || theme.Border != DefaultTheme().Border || theme.Text != lipgloss.Color("7") {
+		t.Errorf("theme = %+v, want the valid colors applied over the default theme", theme)
+	}
+
+	if theme, err = readThemeFile(write("broken.json", `{"border": `)); theme != nil || err == nil {
+		t.Errorf("broken JSON: theme %v, err %v, want no theme and an error", theme, err)
+	}
+	if theme, err = readThemeFile(filepath.Join(dir, "missing.json")); theme != nil || err == nil {
+		t.Errorf("missing file: theme %v, err %v, want no theme and an error", theme, err)
+	}
+}
+
+func TestLoadThemeErrorWidget(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "theme.json")
+	s := NewSkeleton()
+
+	if err := os.WriteFile(path, []byte(`{"border": "nope"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadTheme(path); err == nil {
+		t.Fatal("invalid theme loaded without an error")
+	}
+	if s.widget.GetWidget(themeErrorWidget) == nil || !s.widget.alerts[themeErrorWidget] {
+		t.Fatal("the error of the theme is not shown on an alert widget")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"border": "#268BD2"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LoadTheme(path); err != nil {
+		t.Fatalf("valid theme: %v", err)
+	}
+	if s.widget.GetWidget(themeErrorWidget) != nil || s.widget.alerts[themeErrorWidget] {
+		t.Error("the error widget is kept after the theme is valid again")
+	}
+}
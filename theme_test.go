@@ -0,0 +1,123 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"testing"
+)
+
+// themeRecorder is a page that records the themes it receives.
+type themeRecorder struct {
+	themes *[]Theme
+}
+
+func (r themeRecorder) Init() tea.Cmd { return nil }
+
+func (r themeRecorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(ThemeChanged); ok {
+		*r.themes = append(*r.themes, msg.Theme)
+	}
+	return r, nil
+}
+
+func (r themeRecorder) View() string { return "" }
+
+func TestThemes(t *testing.T) {
+	names := make(map[string]bool)
+	for _, theme := range Themes() {
+		if theme.Name == "" || names[theme.Name] {
+			t.Errorf("theme name %q is empty or not unique", theme.Name)
+		}
+		names[theme.Name] = true
+
+		for name, color := range map[string]lipgloss.TerminalColor{
+			"Border": theme.Border, "Muted": theme.Muted, "Accent": theme.Accent,
+			"Warning": theme.Warning, "Error": theme.Error, "ActiveTabBorder": theme.ActiveTabBorder,
+		} {
+			if color == nil {
+				t.Errorf("theme %q has no %s color", theme.Name, name)
+			}
+		}
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	var themes []Theme
+	s := NewSkeleton().SetMonochrome(false)
+	s.AddPage("first", "First", themeRecorder{themes: &themes})
+
+	s.SetTheme(NordTheme())
+	if got := s.GetTheme(); got.Name != "Nord" || got.Border != NordTheme().Border {
+		t.Fatalf("theme = %+v, want the Nord theme", got)
+	}
+	if s.header.properties.borderColor != NordTheme().Border {
+		t.Errorf("header border = %v, want the border of the theme", s.header.properties.borderColor)
+	}
+
+	// the pending messages, e.g. AddPage, are applied as well
+	for len(themes) == 0 {
+		s.Update(<-s.updateChan)
+	}
+	if len(themes) != 1 || themes[0].Name != "Nord" {
+		t.Errorf("page themes = %v, want ThemeChanged with the Nord theme", themes)
+	}
+
+	s.SetBorderColor("#7D56F4")
+	if got := s.GetTheme().Border; got != lipgloss.Color("#7D56F4") {
+		t.Errorf("theme border = %v, want the color set by SetBorderColor", got)
+	}
+}
+
+func TestCustomStyleColorsSurviveTheColorSetters(t *testing.T) {
+	s := NewSkeleton().SetMonochrome(false)
+	red, blue := lipgloss.Color("#ff0000"), lipgloss.Color("#0000ff")
+	s.SetActiveTabStyle(lipgloss.NewStyle().Padding(0, 1).Foreground(red))
+	s.SetWidgetStyle(lipgloss.NewStyle().Padding(0, 1).BorderForeground(blue))
+
+	s.SetBorderColor("#00ff00")
+	s.SetTheme(NordTheme())
+	s.SetTheme(DefaultTheme())
+	s.SetMonochrome(true).SetMonochrome(false)
+
+	if got := s.GetActiveTabStyle().GetForeground(); got != red {
+		t.Errorf("active tab foreground = %v, want the color of the custom style", got)
+	}
+	if got := s.GetActiveTabStyle().GetBorderTopForeground(); got != DefaultTheme().ActiveTabBorder {
+		t.Errorf("active tab border = %v, want the theme color the custom style does not set", got)
+	}
+	if got := s.GetWidgetStyle().GetBorderTopForeground(); got != blue {
+		t.Errorf("widget border = %v, want the color of the custom style", got)
+	}
+
+	s.SetActiveTabTextColor("#ffffff")
+	s.SetTheme(NordTheme())
+	if got := s.GetActiveTabStyle().GetForeground(); got != NordTheme().ActiveTabText {
+		t.Errorf("active tab foreground = %v, want the theme color after the color setter", got)
+	}
+}
+
+func TestColorSetterChangesItsColorOnly(t *testing.T) {
+	s := NewSkeleton().SetMonochrome(false)
+	s.SetInactiveTabTextColor("#ff0000")
+	s.SetBorderColor("#00ff00")
+
+	if got := s.GetInactiveTabStyle().GetForeground(); got != lipgloss.Color("#ff0000") {
+		t.Errorf("inactive tab foreground = %v, want the color of SetInactiveTabTextColor", got)
+	}
+	if got := s.GetBorderColor(); got != "#00ff00" {
+		t.Errorf("border color = %q, want the color of SetBorderColor", got)
+	}
+}
+
+func TestMonochromeDropsTheCustomColors(t *testing.T) {
+	s := NewSkeleton().SetMonochrome(true)
+	s.SetActiveTabStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000")))
+	if _, ok := s.GetActiveTabStyle().GetForeground().(lipgloss.NoColor); !ok {
+		t.Errorf("active tab foreground = %v, want no color in monochrome mode", s.GetActiveTabStyle().GetForeground())
+	}
+
+	s.SetMonochrome(false)
+	if got := s.GetActiveTabStyle().GetForeground(); got != lipgloss.Color("#ff0000") {
+		t.Errorf("active tab foreground = %v, want the custom color back", got)
+	}
+}
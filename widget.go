@@ -16,12 +16,30 @@ type widget struct {
 	// widgets are hold the widgets
 	widgets []*commonWidget
 
+	// indicator is hold the transient status shown before the widgets, e.g. the pending key sequence
+	indicator string
+
+	// focused is hold the index of the selected widget while the status bar has the focus, it is -1 otherwise
+	focused int
+
+	// clickHandlers are hold the callbacks of the widgets by their keys, they are called when the widget is clicked
+	clickHandlers map[string]func() tea.Cmd
+
+	// alerts are hold the keys of the widgets rendered with the error style
+	alerts map[string]bool
+
 	// properties are hold the properties of the widget
 	properties *widgetProperties
 
 	// widgetLength is hold the length of the widget
 	widgetLength int
 
+	// offset is hold the index of the first visible widget, the widgets scroll when they do not fit
+	offset int
+
+	// visibleEnd is hold the index after the last visible widget
+	visibleEnd int
+
 	// updateChan is hold the update channel
 	updateChan chan any
 }
@@ -29,9 +47,12 @@ type widget struct {
 // newWidget returns a new Widget.
 func newWidget() *widget {
 	return &widget{
-		properties: defaultWidgetProperties(),
-		viewport:   newTerminalViewport(),
-		updateChan: make(chan any),
+		properties:    defaultWidgetProperties(),
+		viewport:      newTerminalViewport(),
+		focused:       -1,
+		clickHandlers: make(map[string]func() tea.Cmd),
+		alerts:        make(map[string]bool),
+		updateChan:    make(chan any),
 	}
 }
 
@@ -41,45 +62,143 @@ type commonWidget struct {
 }
 
 type widgetProperties struct {
-	borderColor     string
-	leftTabPadding  int
-	rightTabPadding int
-	widgetStyle     lipgloss.Style
+	borderColor    lipgloss.TerminalColor
+	darkBackground bool
+	frameAccent    lipgloss.TerminalColor
+	borderSet      BorderSet
+	glyphs         glyphs
+	monochrome     bool
+	widgetStyle    lipgloss.Style
+	customColors   styleColors
+	indicatorStyle lipgloss.Style
+	errorColor     lipgloss.TerminalColor
+	renderer       StatusBarRenderer
+	position       lipgloss.Position
 }
 
 func defaultWidgetProperties() *widgetProperties {
-	borderColor := "39"
-	leftPadding := 2
-	rightPadding := 2
+	theme := DefaultTheme()
+	borderSet := RoundedBorderSet()
+	padding := 2
 	return &widgetProperties{
-		borderColor:     borderColor,
-		leftTabPadding:  leftPadding,
-		rightTabPadding: rightPadding,
-		widgetStyle: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("49"))
-		}(),
+		borderColor: theme.Border,
+		borderSet:   borderSet,
+		glyphs:      unicodeGlyphs,
+		widgetStyle: lipgloss.NewStyle().BorderStyle(borderSet.Tab).
+			Padding(0, padding).
+			BorderForeground(theme.WidgetBorder),
+		indicatorStyle: lipgloss.NewStyle().BorderStyle(borderSet.Tab).
+			Padding(0, padding).
+			BorderForeground(theme.Accent).Foreground(theme.Accent),
+		errorColor: theme.Error,
+		position:   lipgloss.Bottom,
 	}
 }
 
 // SetBorderColor sets the border color of the Widget.
 func (w *widget) SetBorderColor(color string) *widget {
-	w.properties.borderColor = color
+	if !w.properties.monochrome {
+		w.properties.borderColor = lipgloss.Color(color)
+	}
 	return w
 }
 
+// applyTheme sets the border color and the widget colors by the given theme.
+// The colors of the custom widget style are kept, they are dropped in monochrome mode only.
+func (w *widget) applyTheme(theme Theme) {
+	custom := w.properties.customColors
+	if w.properties.monochrome {
+		custom = styleColors{}
+	}
+	w.properties.borderColor = theme.Border
+	w.properties.widgetStyle = paintStyle(w.properties.widgetStyle,
+		custom.or(styleColors{theme.WidgetText, theme.WidgetBorder}))
+	w.properties.indicatorStyle = w.properties.indicatorStyle.
+		Foreground(theme.Accent).BorderForeground(theme.Accent)
+	w.properties.errorColor = theme.Error
+}
+
+// setDarkBackground sets the background of the terminal is dark or not, the adaptive border color is read by it.
+func (w *widget) setDarkBackground(dark bool) {
+	w.properties.darkBackground = dark
+}
+
+// setBorderSet sets the frame and the widget borders by the given border set, the overflow indicators are drawn with the given glyphs.
+func (w *widget) setBorderSet(set BorderSet, g glyphs) {
+	w.properties.borderSet = set
+	w.properties.glyphs = g
+	w.properties.widgetStyle = w.properties.widgetStyle.BorderStyle(set.Tab)
+	w.properties.indicatorStyle = w.properties.indicatorStyle.BorderStyle(set.Tab)
+	w.calculateWidgetLength()
+}
+
+// setMonochrome sets the widgets are distinguished by the text attributes instead of the colors or not.
+// The indicator is bold in monochrome mode.
+func (w *widget) setMonochrome(monochrome bool) {
+	renderer := chromeRenderer(monochrome)
+	w.properties.monochrome = monochrome
+	w.properties.widgetStyle = w.properties.widgetStyle.Renderer(renderer)
+	w.properties.indicatorStyle = w.properties.indicatorStyle.Renderer(renderer).Bold(monochrome)
+}
+
+// SetWidgetStyle sets the style of the widgets, the border and the colors the style does not set are kept.
+func (w *widget) SetWidgetStyle(style lipgloss.Style) {
+	w.properties.customColors = colorsOf(style).or(w.properties.customColors)
+	style = inheritStyle(style, w.properties.widgetStyle, w.properties.borderSet.Tab)
+	if w.properties.monochrome {
+		style = paintStyle(style, styleColors{})
+	}
+	w.properties.widgetStyle = style.Renderer(chromeRenderer(w.properties.monochrome))
+	w.calculateWidgetLength()
+}
+
+// GetWidgetStyle returns the style of the widgets.
+func (w *widget) GetWidgetStyle() lipgloss.Style {
+	return w.properties.widgetStyle
+}
+
+// setFrameAccent sets the color that tints the frame line and the corners instead of the border color, nil removes it.
+func (w *widget) setFrameAccent(color lipgloss.TerminalColor) {
+	w.properties.frameAccent = color
+}
+
+// frameColor returns the color of the frame line and the corners.
+func (w *widget) frameColor() lipgloss.TerminalColor {
+	if w.properties.frameAccent != nil {
+		return w.properties.frameAccent
+	}
+	return w.properties.borderColor
+}
+
+// SetPosition sets the widget bar is rendered on the top (lipgloss.Top) or on the bottom (lipgloss.Bottom) of the frame.
+func (w *widget) SetPosition(position lipgloss.Position) {
+	w.properties.position = position
+}
+
+// GetPosition returns the widget bar is rendered on the top or on the bottom of the frame.
+func (w *widget) GetPosition() lipgloss.Position {
+	return w.properties.position
+}
+
+// Height returns the height of the widget bar, it is one row shorter if there is nothing to show.
+func (w *widget) Height() int {
+	if w.IsEmpty() {
+		return headerHeight - 1
+	}
+	return headerHeight
+}
+
 // GetBorderColor returns the border color of the Widget.
 func (w *widget) GetBorderColor() string {
-	return w.properties.borderColor
+	return colorString(w.properties.borderColor, w.properties.darkBackground)
 }
 
-// SetWidgetBorderColor sets the border color of the Widget.
+// SetWidgetBorderColor sets the border color of the Widget, it replaces the color of a custom style.
 func (w *widget) SetWidgetBorderColor(color string) *widget {
-	w.properties.widgetStyle = w.properties.widgetStyle.BorderForeground(lipgloss.Color(color))
+	w.properties.customColors.border = nil
+	if !w.properties.monochrome {
+		w.properties.widgetStyle = w.properties.widgetStyle.BorderForeground(lipgloss.Color(color))
+	}
 	return w
 }
 
@@ -90,15 +209,17 @@ func (w *widget) GetWidgetBorderColor() string {
 
 // SetLeftPadding sets the left padding of the Widget.
 func (w *widget) SetLeftPadding(padding int) *widget {
-	w.properties.leftTabPadding = padding
 	w.properties.widgetStyle = w.properties.widgetStyle.PaddingLeft(padding)
+	w.properties.indicatorStyle = w.properties.indicatorStyle.PaddingLeft(padding)
+	w.calculateWidgetLength()
 	return w
 }
 
 // SetRightPadding sets the right padding of the Widget.
 func (w *widget) SetRightPadding(padding int) *widget {
-	w.properties.rightTabPadding = padding
 	w.properties.widgetStyle = w.properties.widgetStyle.PaddingRight(padding)
+	w.properties.indicatorStyle = w.properties.indicatorStyle.PaddingRight(padding)
+	w.calculateWidgetLength()
 	return w
 }
 
@@ -113,6 +234,149 @@ func (w *widget) GetWidget(key string) *commonWidget {
 	return nil
 }
 
+// SetIndicator sets the transient status shown before the widgets, empty text hides it.
+func (w *widget) SetIndicator(text string) {
+	w.indicator = text
+	w.calculateWidgetLength()
+}
+
+// GetIndicator returns the transient status shown before the widgets.
+func (w *widget) GetIndicator() string {
+	return w.indicator
+}
+
+// SetFocused selects the widget by the given index while the status bar has the focus, -1 removes the selection.
+func (w *widget) SetFocused(index int) {
+	w.focused = max(min(index, len(w.widgets)-1), -1)
+	w.layoutWidgets()
+}
+
+// MoveFocus moves the selection by the given step, negative step moves to the left.
+func (w *widget) MoveFocus(step int) {
+	if w.focused < 0 || len(w.widgets) == 0 {
+		return
+	}
+	w.SetFocused(max(w.focused+step, 0))
+}
+
+// GetFocused returns the index of the selected widget, it is -1 if there is no selection.
+func (w *widget) GetFocused() int {
+	return w.focused
+}
+
+// WidgetAt returns the key of the widget at the given position of the widget bar, the position is relative to the widget bar.
+func (w *widget) WidgetAt(x, y int) (string, bool) {
+	requiredLineCount := w.viewport.Width - (w.widgetLength + 2)
+	if y < 0 || y > 2 || requiredLineCount < 0 {
+		return "", false
+	}
+
+	start := 1 + requiredLineCount // for the left corner and the line
+	if w.indicator != "" {
+		start += lipgloss.Width(w.renderIndicator())
+	}
+	if w.offset > 0 {
+		start += lipgloss.Width(overflowText(w.offset, true, "", w.properties.glyphs))
+	}
+	for i := w.offset; i < w.visibleEnd; i++ {
+		width := lipgloss.Width(w.renderWidget(i))
+		if x >= start && x < start+width {
+			return w.widgets[i].Key, true
+		}
+		start += width
+	}
+
+	return "", false
+}
+
+// widgetState returns the state of the widget by the given index.
+func (w *widget) widgetState(index int) WidgetState {
+	return WidgetState{
+		Focused: index == w.focused,
+		Alert:   w.alerts[w.widgets[index].Key],
+	}
+}
+
+// renderStyle returns the style of the widget by the given state.
+// The alerts are rendered with the error color, the focused widget is reversed and the indicator has the accent color.
+func (w *widget) renderStyle(state WidgetState) lipgloss.Style {
+	if state.Indicator {
+		return w.properties.indicatorStyle
+	}
+
+	style := w.properties.widgetStyle
+	if state.Alert {
+		style = style.Foreground(w.properties.errorColor).BorderForeground(w.properties.errorColor).Bold(true)
+	}
+	if state.Focused {
+		style = style.Reverse(true)
+	}
+	return style
+}
+
+// renderWidget renders the widget by the given index with the status bar renderer.
+func (w *widget) renderWidget(index int) string {
+	return placeOnFrame(w.GetStatusBarRenderer().RenderWidget(w.widgets[index].Value, w.widgetState(index), index))
+}
+
+// renderIndicator renders the indicator with the status bar renderer.
+func (w *widget) renderIndicator() string {
+	return placeOnFrame(w.GetStatusBarRenderer().RenderWidget(w.indicator, WidgetState{Indicator: true}, -1))
+}
+
+// renderOverflow renders the indicator of the hidden widgets.
+func (w *widget) renderOverflow(count int, before bool) string {
+	style := lipgloss.NewStyle().
+		Foreground(w.properties.widgetStyle.GetForeground()).
+		Renderer(chromeRenderer(w.properties.monochrome))
+	return placeOnFrame(style.Render(overflowText(count, before, "", w.properties.glyphs)))
+}
+
+// SetStatusBarRenderer sets the renderer of the widgets, nil restores the default one.
+func (w *widget) SetStatusBarRenderer(renderer StatusBarRenderer) {
+	w.properties.renderer = renderer
+	w.calculateWidgetLength()
+}
+
+// GetStatusBarRenderer returns the renderer of the widgets, it is the default one if no renderer is set.
+func (w *widget) GetStatusBarRenderer() StatusBarRenderer {
+	if w.properties.renderer == nil {
+		return defaultStatusBarRenderer{widget: w}
+	}
+	return w.properties.renderer
+}
+
+// SetClickHandler sets the callback of the widget by the given key, nil removes it.
+func (w *widget) SetClickHandler(key string, handler func() tea.Cmd) {
+	if handler == nil {
+		delete(w.clickHandlers, key)
+		return
+	}
+	w.clickHandlers[key] = handler
+}
+
+// SetAlert sets the widget by the given key is rendered with the error color or not.
+func (w *widget) SetAlert(key string, alert bool) {
+	if !alert {
+		delete(w.alerts, key)
+		return
+	}
+	w.alerts[key] = true
+}
+
+// Click calls the callback of the widget by the given key, it returns nil if there is no callback.
+func (w *widget) Click(key string) tea.Cmd {
+	if handler, ok := w.clickHandlers[key]; ok {
+		return handler()
+	}
+	return nil
+}
+
+// IsEmpty returns there is nothing to show on the widget bar or not.
+func (w *widget) IsEmpty() bool {
+	return len(w.widgets) == 0 && w.indicator == ""
+}
+
 // DeleteAllWidgets deletes all the widgets.
 func (w *widget) DeleteAllWidgets() {
 	w.widgets = nil
@@ -220,22 +484,44 @@ func (w *widget) Update(msg tea.Msg) (*widget, tea.Cmd) {
 }
 
 // calculateWidgetLength calculates the length of the widgets.
+// The terminal size is not enough only if the indicator and the selected (or the first) widget do not fit, the other widgets scroll.
 func (w *widget) calculateWidgetLength() {
-	var widgetLen int
-	for _, widget := range w.widgets {
-		widgetLen += len([]rune(widget.Value))
-		widgetLen += w.properties.leftTabPadding + w.properties.rightTabPadding
-		widgetLen += 2 // for the border between widgets
+	w.SendIsTerminalSizeEnough(w.layoutWidgets())
+}
+
+// layoutWidgets measures the rendered widgets and calculates the visible ones around the selected widget.
+// It returns the indicator and the selected widget fit into the widget bar or not.
+func (w *widget) layoutWidgets() bool {
+	var indicatorWidth int
+	if w.indicator != "" {
+		indicatorWidth = lipgloss.Width(w.renderIndicator())
 	}
 
-	requiredLineCount := w.viewport.Width - (widgetLen + 2)
-	if requiredLineCount < 0 {
-		w.SendIsTerminalSizeEnough(false)
-	} else {
-		w.SendIsTerminalSizeEnough(true)
+	widths := make([]int, len(w.widgets))
+	for i := range w.widgets {
+		widths[i] = lipgloss.Width(w.renderWidget(i))
 	}
 
-	w.widgetLength = widgetLen
+	start, end, ok := fitWindow(widths, max(w.focused, 0), w.offset, w.viewport.Width-2-indicatorWidth, w.overflowWidth)
+	w.offset, w.visibleEnd = start, end
+
+	w.widgetLength = indicatorWidth + w.overflowWidth(start, len(widths)-end)
+	for _, width := range widths[start:end] {
+		w.widgetLength += width
+	}
+	return ok && indicatorWidth <= w.viewport.Width-2
+}
+
+// overflowWidth returns the width of the indicators of the hidden widgets.
+func (w *widget) overflowWidth(before, after int) int {
+	var width int
+	if before > 0 {
+		width += lipgloss.Width(overflowText(before, true, "", w.properties.glyphs))
+	}
+	if after > 0 {
+		width += lipgloss.Width(overflowText(after, false, "", w.properties.glyphs))
+	}
+	return width
 }
 
 func (w *widget) View() string {
@@ -249,27 +535,50 @@ func (w *widget) View() string {
 		return ""
 	}
 
-	line := strings.Repeat("─", requiredLineCount)
-	line = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(line)
+	frame := w.properties.borderSet.Frame
+	top := w.properties.position == lipgloss.Top
+	lineGlyph := frame.Bottom
+	if top {
+		lineGlyph = frame.Top
+	}
+	line := strings.Repeat(lineGlyph, requiredLineCount)
+	line = lipgloss.NewStyle().Foreground(w.frameColor()).Render(line)
 
-	var renderedWidgets = make([]string, len(w.widgets))
-	for i, wgt := range w.widgets {
-		renderedWidgets[i] = w.properties.widgetStyle.Render(wgt.Value)
+	var renderedWidgets []string
+	if w.indicator != "" {
+		renderedWidgets = append(renderedWidgets, w.renderIndicator())
+	}
+	if w.offset > 0 {
+		renderedWidgets = append(renderedWidgets, w.renderOverflow(w.offset, true))
+	}
+	for i := w.offset; i < w.visibleEnd; i++ {
+		renderedWidgets = append(renderedWidgets, w.renderWidget(i))
+	}
+	if w.visibleEnd < len(w.widgets) {
+		renderedWidgets = append(renderedWidgets, w.renderOverflow(len(w.widgets)-w.visibleEnd, false))
 	}
 
-	leftCorner := lipgloss.JoinVertical(lipgloss.Top, "│", "╰")
-	rightCorner := lipgloss.JoinVertical(lipgloss.Top, "│", "╯")
-	leftCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(leftCorner)
-	rightCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(rightCorner)
+	leftCorner := lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.BottomLeft)
+	rightCorner := lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.BottomRight)
+	position := lipgloss.Center
+	if !w.IsEmpty() {
+		position = lipgloss.Top
+	}
+	if top {
+		// the corners are below the frame line, the line is on the first row if there is nothing to show
+		leftCorner = lipgloss.JoinVertical(lipgloss.Top, frame.TopLeft, frame.Left)
+		rightCorner = lipgloss.JoinVertical(lipgloss.Top, frame.TopRight, frame.Right)
+		position = lipgloss.Bottom
+		if w.IsEmpty() {
+			position = lipgloss.Top
+		}
+	}
+	leftCorner = lipgloss.NewStyle().Foreground(w.frameColor()).Render(leftCorner)
+	rightCorner = lipgloss.NewStyle().Foreground(w.frameColor()).Render(rightCorner)
 
 	var bottom []string
 	bottom = append(bottom, line)
 	bottom = append(bottom, renderedWidgets...)
 
-	position := lipgloss.Center
-	if len(w.widgets) > 0 {
-		position = lipgloss.Top
-	}
-
 	return lipgloss.JoinHorizontal(position, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, bottom...), rightCorner)
 }
@@ -0,0 +1,67 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+)
+
+// SetZoom sets the active page uses the whole terminal or not, e.g. for the pages with big tables.
+// The header, the widgets and the side borders are hidden while zoomed, a hint on the row above the page shows how to exit.
+// The pages receive a ContentSizeMsg with the new size of the page content.
+func (s *Skeleton) SetZoom(zoom bool) *Skeleton {
+	s.zoomed = zoom
+	if zoom {
+		s.SetFocus(FocusPage) // the tab bar and the status bar are hidden
+	}
+	s.triggerUpdate()
+	return s
+}
+
+// IsZoomed returns the active page uses the whole terminal or not.
+func (s *Skeleton) IsZoomed() bool {
+	return s.zoomed
+}
+
+// ToggleZoom zooms the active page in or out.
+func (s *Skeleton) ToggleZoom() *Skeleton {
+	return s.SetZoom(!s.zoomed)
+}
+
+// zoomHint returns the hint that shows how to exit the zoom, it is empty if the zoom key binding is disabled.
+func (s *Skeleton) zoomHint() string {
+	if !s.KeyMap.Zoom.Enabled() {
+		return ""
+	}
+
+	theme := s.chromeTheme()
+	style := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Reverse(s.properties.monochrome).
+		Renderer(chromeRenderer(s.properties.monochrome))
+	return style.Render(" " + bindingHelpKey(s.KeyMap.Zoom) + " exit zoom ")
+}
+
+// zoomHintHeight returns the height of the row of the hint, it is 0 if there is no hint.
+func (s *Skeleton) zoomHintHeight() int {
+	if !s.KeyMap.Zoom.Enabled() {
+		return 0
+	}
+	return 1
+}
+
+// zoomView renders the body on the whole terminal below the row of the hint, the hint is on the right of its row.
+func (s *Skeleton) zoomView(body string) string {
+	lines := strings.Split(body, "\n")
+	lines = lines[:min(len(lines), max(s.viewport.Height-s.zoomHintHeight(), 0))]
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, s.viewport.Width, "")
+	}
+
+	if hint := s.zoomHint(); hint != "" {
+		hint = ansi.Truncate(hint, s.viewport.Width, "")
+		row := strings.Repeat(" ", max(s.viewport.Width-lipgloss.Width(hint), 0)) + hint
+		lines = append([]string{row}, lines...)
+	}
+	return strings.Join(lines, "\n")
+}
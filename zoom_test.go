@@ -0,0 +1,60 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"strings"
+	"testing"
+)
+
+// linesPage is a page that renders the given count of numbered lines and records the mouse events it receives.
+type linesPage struct {
+	count int
+	mouse *[]tea.MouseMsg
+}
+
+func (p linesPage) Init() tea.Cmd { return nil }
+
+func (p linesPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.MouseMsg); ok {
+		*p.mouse = append(*p.mouse, msg)
+	}
+	return p, nil
+}
+
+func (p linesPage) View() string {
+	lines := make([]string, p.count)
+	for i := range lines {
+		lines[i] = "line " + strings.Repeat("=", i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestZoomReservesTheHintRow(t *testing.T) {
+	var mouse []tea.MouseMsg
+	s := NewSkeleton()
+	s.AddPage("table", "Table", linesPage{count: 30, mouse: &mouse})
+	s.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+	s.SetZoom(true)
+
+	if width, height := s.GetContentSize(); width != 40 || height != 9 {
+		t.Errorf("content size = %dx%d, want 40x9", width, height)
+	}
+
+	lines := strings.Split(s.View(), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("view height = %d, want the terminal height", len(lines))
+	}
+	if hint := ansi.Strip(lines[0]); !strings.HasSuffix(hint, "alt+z exit zoom ") || strings.Contains(hint, "line") {
+		t.Errorf("first row = %q, want the hint only", hint)
+	}
+	if first := ansi.Strip(lines[1]); first != "line " {
+		t.Errorf("second row = %q, want the first row of the page", first)
+	}
+
+	s.Update(tea.MouseMsg{X: 3, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	s.Update(tea.MouseMsg{X: 3, Y: 4, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if len(mouse) != 1 || mouse[0].Y != 3 {
+		t.Errorf("mouse events = %v, want the event below the hint relative to the page", mouse)
+	}
+}